@@ -0,0 +1,373 @@
+// Package a11y checks compiled templates for common accessibility problems, such as images without alternative text,
+// text with a low contrast to its background or layout tables that screen readers announce as data tables. Problems are
+// returned as mjml.ErrorDetail, like validation errors, with the lines of the mjml source they refer to.
+//
+//	result, err := mjml.Compile(ctx, input)
+//	problems, err := a11y.Check(result)
+package a11y
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+	"github.com/Boostport/mjml-go/ast"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// MinContrast is the minimum contrast ratio of text to its background, as required by WCAG 2 level AA
+const MinContrast = 4.5
+
+// MinLargeTextContrast is the minimum contrast ratio of large text, which is at least 24px, or 18.66px and bold
+const MinLargeTextContrast = 3.0
+
+// genericButtonTexts are texts that do not describe the target of a button
+var genericButtonTexts = []string{"click", "click here", "here", "link", "more", "read more", "learn more", "go", "this"}
+
+// Check checks the mjml and html of a compiled template for:
+//
+//   - mj-image without an alt attribute
+//   - mjml without lang or dir attributes
+//   - text with a contrast to its background below MinContrast, computed from the attributes of components and their
+//     ancestors, including mj-attributes and the defaults of mjml
+//   - mj-button without text, or with text such as "click here" that does not describe the link
+//   - layout tables without role="presentation", which are tables without th or caption elements
+func Check(result *mjml.Result) ([]mjml.ErrorDetail, error) {
+	root, err := result.MJML()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := checker{attributes: newAttributes(root)}
+
+	c.root(root)
+
+	if body := root.Child("mj-body"); body != nil {
+		c.walk(body, nil)
+	}
+
+	if err := c.tables(result.HTML); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(c.details, func(a, b mjml.ErrorDetail) int {
+		return a.Line - b.Line
+	})
+
+	return c.details, nil
+}
+
+type checker struct {
+	attributes *attributes
+	details    []mjml.ErrorDetail
+
+	// layoutTables are the lines and tags of the components generating layout tables without a role, in document order
+	layoutTables []mjml.ErrorDetail
+}
+
+func (c *checker) add(n *ast.Node, message string) {
+	c.details = append(c.details, mjml.ErrorDetail{Line: n.Line, Message: message, TagName: n.Tag})
+}
+
+func (c *checker) root(root *ast.Node) {
+	for _, name := range []string{"lang", "dir"} {
+		if _, ok := root.Attr(name); !ok {
+			c.add(root, fmt.Sprintf("mjml has no %s attribute, so screen readers cannot determine the language and direction of the text", name))
+		}
+	}
+}
+
+// walk checks a component and its descendants. ancestors are the components containing n, outermost first.
+func (c *checker) walk(n *ast.Node, ancestors []*ast.Node) {
+	if n.Type != ast.ElementNode {
+		return
+	}
+
+	switch n.Tag {
+	case "mj-image":
+		if _, ok := c.attributes.get(n, "alt"); !ok {
+			c.add(n, `mj-image has no alt attribute, use alt="" if the image is decorative`)
+		}
+
+	case "mj-button":
+		c.button(n)
+		c.contrast(n, ancestors)
+
+	case "mj-text", "mj-navbar-link":
+		c.contrast(n, ancestors)
+
+	case "mj-table":
+		c.contrast(n, ancestors)
+
+		if c.attributes.value(n, "role") == "" && !isDataTable(n.Content) {
+			c.layoutTables = append(c.layoutTables, mjml.ErrorDetail{Line: n.Line, TagName: n.Tag})
+		}
+
+	case "mj-accordion", "mj-accordion-element":
+		// mjml generates a table without a role for the accordion and each of its elements
+		c.layoutTables = append(c.layoutTables, mjml.ErrorDetail{Line: n.Line, TagName: n.Tag})
+	}
+
+	if ast.IsEndingTag(n.Tag) && n.Content != "" {
+		for range contentLayoutTables(n.Content) {
+			c.layoutTables = append(c.layoutTables, mjml.ErrorDetail{Line: n.Line, TagName: n.Tag})
+		}
+	}
+
+	ancestors = append(ancestors, n)
+
+	for _, child := range n.Children {
+		c.walk(child, ancestors)
+	}
+}
+
+func (c *checker) button(n *ast.Node) {
+	text := strings.Join(strings.Fields(contentText(n.Content)), " ")
+
+	switch {
+	case text == "":
+		c.add(n, "mj-button has no text")
+
+	case slices.Contains(genericButtonTexts, strings.ToLower(strings.TrimRight(text, ".!"))):
+		c.add(n, fmt.Sprintf("mj-button text %q does not describe the link", text))
+	}
+}
+
+// contrast checks the contrast of the text of a component to its background
+func (c *checker) contrast(n *ast.Node, ancestors []*ast.Node) {
+	if n.Tag != "mj-button" && strings.TrimSpace(contentText(n.Content)) == "" {
+		return
+	}
+
+	foreground, ok := parseColor(c.attributes.value(n, "color"))
+
+	if !ok {
+		return
+	}
+
+	background, ok := c.background(n, ancestors)
+
+	if !ok {
+		return
+	}
+
+	minimum := MinContrast
+
+	if c.largeText(n) {
+		minimum = MinLargeTextContrast
+	}
+
+	if ratio := contrast(foreground, background); ratio < minimum {
+		c.add(n, fmt.Sprintf("Contrast ratio of the text color %s on the background %s is %s:1, below the minimum of %s:1",
+			c.attributes.value(n, "color"), formatColor(background), formatRatio(ratio), formatRatio(minimum)))
+	}
+}
+
+// background returns the background color behind the text of a component, reporting false if it cannot be
+// determined, for example because it is a background image
+func (c *checker) background(n *ast.Node, ancestors []*ast.Node) (color, bool) {
+	names := []string{"container-background-color"}
+
+	if n.Tag == "mj-button" {
+		names = []string{"background-color", "container-background-color"}
+	}
+
+	if background, ok := c.backgroundColor(n, names...); ok {
+		return background, true
+	}
+
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		ancestor := ancestors[i]
+
+		switch ancestor.Tag {
+		case "mj-column":
+			names = []string{"inner-background-color", "background-color"}
+
+		case "mj-section", "mj-wrapper", "mj-hero":
+			if c.attributes.value(ancestor, "background-url") != "" {
+				return color{}, false
+			}
+
+			names = []string{"background-color"}
+
+		case "mj-body":
+			names = []string{"background-color"}
+
+		default:
+			continue
+		}
+
+		if background, ok := c.backgroundColor(ancestor, names...); ok {
+			return background, true
+		}
+	}
+
+	return color{r: 255, g: 255, b: 255}, true
+}
+
+// backgroundColor returns the first of the named colors set on a component
+func (c *checker) backgroundColor(n *ast.Node, names ...string) (color, bool) {
+	for _, name := range names {
+		if value := c.attributes.value(n, name); value != "" {
+			return parseColor(value)
+		}
+	}
+
+	return color{}, false
+}
+
+func (c *checker) largeText(n *ast.Node) bool {
+	size, err := strconv.ParseFloat(strings.TrimSuffix(c.attributes.value(n, "font-size"), "px"), 64)
+
+	if err != nil {
+		return false
+	}
+
+	weight := c.attributes.value(n, "font-weight")
+	numericWeight, _ := strconv.Atoi(weight)
+	bold := weight == "bold" || weight == "bolder" || numericWeight >= 700
+
+	return size >= 24 || (size >= 18.66 && bold)
+}
+
+// tables checks for layout tables without a role in the html. The tables are reported on the lines of the
+// components generating them if they match those found in the mjml, which is not the case for tables generated by
+// custom components.
+func (c *checker) tables(document string) error {
+	doc, err := html.Parse(strings.NewReader(document))
+
+	if err != nil {
+		return fmt.Errorf("error parsing html: %w", err)
+	}
+
+	count := len(layoutTables(doc))
+
+	const message = `Layout table has no role="presentation", so screen readers announce it as a data table`
+
+	if count == len(c.layoutTables) {
+		for _, table := range c.layoutTables {
+			table.Message = message
+			c.details = append(c.details, table)
+		}
+
+		return nil
+	}
+
+	for range count {
+		c.details = append(c.details, mjml.ErrorDetail{Message: message, TagName: "table"})
+	}
+
+	return nil
+}
+
+// contentLayoutTables returns the layout tables in the html content of a component
+func contentLayoutTables(content string) []*html.Node {
+	if !strings.Contains(content, "<table") {
+		return nil
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+
+	if err != nil {
+		return nil
+	}
+
+	var tables []*html.Node
+
+	for _, n := range nodes {
+		tables = append(tables, layoutTables(n)...)
+	}
+
+	return tables
+}
+
+// layoutTables returns the tables without a role that are not data tables
+func layoutTables(n *html.Node) []*html.Node {
+	var tables []*html.Node
+
+	if n.DataAtom == atom.Table && attr(n, "role") == "" && !hasDataCells(n) {
+		tables = append(tables, n)
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		tables = append(tables, layoutTables(child)...)
+	}
+
+	return tables
+}
+
+// isDataTable reports whether the rows of an mj-table contain header cells
+func isDataTable(content string) bool {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{Type: html.ElementNode, Data: "table", DataAtom: atom.Table})
+
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(nodes, hasDataCells)
+}
+
+// hasDataCells reports whether a node contains th or caption elements, excluding those of nested tables
+func hasDataCells(n *html.Node) bool {
+	if n.DataAtom == atom.Th || n.DataAtom == atom.Caption {
+		return true
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.DataAtom != atom.Table && hasDataCells(child) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contentText returns the text of html content, with images replaced by their alt text
+func contentText(content string) string {
+	var sb strings.Builder
+
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return sb.String()
+
+		case html.TextToken:
+			sb.Write(z.Text())
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if token := z.Token(); token.DataAtom == atom.Img {
+				for _, a := range token.Attr {
+					if a.Key == "alt" {
+						sb.WriteString(" " + a.Val + " ")
+					}
+				}
+			}
+		}
+	}
+}
+
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+func formatColor(c color) string {
+	return fmt.Sprintf("#%02x%02x%02x", int(c.r), int(c.g), int(c.b))
+}
+
+// formatRatio formats a contrast ratio rounded down to two decimals, so ratios below a minimum are not shown as equal
+// to it
+func formatRatio(ratio float64) string {
+	return strconv.FormatFloat(float64(int(ratio*100))/100, 'f', -1, 64)
+}
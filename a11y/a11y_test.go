@@ -0,0 +1,101 @@
+package a11y
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Boostport/mjml-go"
+)
+
+func TestCheck(t *testing.T) {
+
+	input := `<mjml lang="en">
+  <mj-head>
+    <mj-attributes>
+      <mj-class name="muted" color="#999999" />
+      <mj-image alt="" />
+      <mj-all font-family="Arial" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body background-color="#222222">
+    <mj-section background-color="#ffffff">
+      <mj-column>
+        <mj-text mj-class="muted">Low contrast</mj-text>
+        <mj-text color="#767676">Enough contrast</mj-text>
+        <mj-text color="#949494" font-size="24px">Large text</mj-text>
+        <mj-image src="https://example.com/logo.png" />
+        <mj-button href="https://example.com">Click here!</mj-button>
+        <mj-button href="https://example.com" background-color="#dddddd"><img src="icon.png" alt="Download the report" /></mj-button>
+        <mj-table><tr><td>Layout</td></tr></mj-table>
+        <mj-table><tr><th>Data</th></tr></mj-table>
+        <mj-raw><table><tr><td>Raw</td></tr></table><table role="presentation"><tr><td>Raw</td></tr></table></mj-raw>
+      </mj-column>
+    </mj-section>
+    <mj-section>
+      <mj-column>
+        <mj-text color="#444444">Body background</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	result, err := mjml.Compile(context.Background(), input)
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	details, err := Check(result)
+
+	if err != nil {
+		t.Fatalf("Error checking template: %s", err)
+	}
+
+	expected := []mjml.ErrorDetail{
+		{Line: 1, TagName: "mjml", Message: "mjml has no dir attribute, so screen readers cannot determine the language and direction of the text"},
+		{Line: 12, TagName: "mj-text", Message: "Contrast ratio of the text color #999999 on the background #ffffff is 2.84:1, below the minimum of 4.5:1"},
+		{Line: 16, TagName: "mj-button", Message: `mj-button text "Click here!" does not describe the link`},
+		{Line: 17, TagName: "mj-button", Message: "Contrast ratio of the text color #ffffff on the background #dddddd is 1.35:1, below the minimum of 4.5:1"},
+		{Line: 18, TagName: "mj-table", Message: `Layout table has no role="presentation", so screen readers announce it as a data table`},
+		{Line: 20, TagName: "mj-raw", Message: `Layout table has no role="presentation", so screen readers announce it as a data table`},
+		{Line: 25, TagName: "mj-text", Message: "Contrast ratio of the text color #444444 on the background #222222 is 1.63:1, below the minimum of 4.5:1"},
+	}
+
+	if !reflect.DeepEqual(details, expected) {
+		t.Errorf("Expected:\n%v\ngot:\n%v", expected, details)
+	}
+}
+
+func TestCheckImages(t *testing.T) {
+
+	result, err := mjml.Compile(context.Background(), `<mjml lang="en" dir="ltr">
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-image src="https://example.com/logo.png" />
+        <mj-button href="https://example.com"></mj-button>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`, mjml.WithTitle("Hello"))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	details, err := Check(result)
+
+	if err != nil {
+		t.Fatalf("Error checking template: %s", err)
+	}
+
+	expected := []mjml.ErrorDetail{
+		{Line: 5, TagName: "mj-image", Message: `mj-image has no alt attribute, use alt="" if the image is decorative`},
+		{Line: 6, TagName: "mj-button", Message: "mj-button has no text"},
+	}
+
+	if !reflect.DeepEqual(details, expected) {
+		t.Errorf("Expected:\n%v\ngot:\n%v", expected, details)
+	}
+}
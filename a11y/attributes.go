@@ -0,0 +1,37 @@
+package a11y
+
+import "github.com/Boostport/mjml-go/ast"
+
+// defaults are the default values of the attributes of components used by the checks, as defined by mjml
+var defaults = map[string]map[string]string{
+	"mj-button":      {"color": "#ffffff", "background-color": "#414141", "font-size": "13px", "font-weight": "normal"},
+	"mj-navbar-link": {"color": "#000000", "font-size": "13px", "font-weight": "normal"},
+	"mj-table":       {"color": "#000000", "font-size": "13px"},
+	"mj-text":        {"color": "#000000", "font-size": "13px"},
+}
+
+// attributes resolves the attributes of components, which are set on the component itself, by its mj-class, by
+// mj-attributes for its tag or mj-all, or by the defaults of mjml, in that order of precedence
+type attributes struct {
+	declared *ast.Attributes
+}
+
+func newAttributes(root *ast.Node) *attributes {
+	return &attributes{declared: ast.NewAttributes(root)}
+}
+
+// get returns the resolved value of an attribute of a component and whether it is set
+func (a *attributes) get(n *ast.Node, name string) (string, bool) {
+	if value, ok := a.declared.Get(n, name); ok {
+		return value, true
+	}
+
+	value, ok := defaults[n.Tag][name]
+
+	return value, ok
+}
+
+func (a *attributes) value(n *ast.Node, name string) string {
+	value, _ := a.get(n, name)
+	return value
+}
@@ -0,0 +1,113 @@
+package a11y
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// namedColors are the basic CSS color keywords
+var namedColors = map[string]string{
+	"black":   "#000000",
+	"silver":  "#c0c0c0",
+	"gray":    "#808080",
+	"grey":    "#808080",
+	"white":   "#ffffff",
+	"maroon":  "#800000",
+	"red":     "#ff0000",
+	"purple":  "#800080",
+	"fuchsia": "#ff00ff",
+	"green":   "#008000",
+	"lime":    "#00ff00",
+	"olive":   "#808000",
+	"yellow":  "#ffff00",
+	"navy":    "#000080",
+	"blue":    "#0000ff",
+	"teal":    "#008080",
+	"aqua":    "#00ffff",
+	"orange":  "#ffa500",
+}
+
+type color struct {
+	r, g, b float64
+}
+
+// parseColor parses hex, rgb() and named colors, reporting false for colors that cannot be compared, such as
+// transparent colors
+func parseColor(value string) (color, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if named, ok := namedColors[value]; ok {
+		value = named
+	}
+
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+
+		if len(hex) != 6 {
+			return color{}, false
+		}
+
+		n, err := strconv.ParseUint(hex, 16, 32)
+
+		if err != nil {
+			return color{}, false
+		}
+
+		return color{r: float64(n >> 16 & 0xff), g: float64(n >> 8 & 0xff), b: float64(n & 0xff)}, true
+	}
+
+	if strings.HasPrefix(value, "rgb(") && strings.HasSuffix(value, ")") {
+		parts := strings.Split(value[len("rgb("):len(value)-1], ",")
+
+		if len(parts) != 3 {
+			return color{}, false
+		}
+
+		var channels [3]float64
+
+		for i, part := range parts {
+			n, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+
+			if err != nil {
+				return color{}, false
+			}
+
+			channels[i] = n
+		}
+
+		return color{r: channels[0], g: channels[1], b: channels[2]}, true
+	}
+
+	return color{}, false
+}
+
+// luminance returns the relative luminance of the color as defined by WCAG
+func (c color) luminance() float64 {
+	channel := func(v float64) float64 {
+		v /= 255
+
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(c.r) + 0.7152*channel(c.g) + 0.0722*channel(c.b)
+}
+
+// contrast returns the WCAG contrast ratio of two colors, between 1 and 21
+func contrast(a color, b color) float64 {
+	l1, l2 := a.luminance(), b.luminance()
+
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return (l1 + 0.05) / (l2 + 0.05)
+}
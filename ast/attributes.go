@@ -0,0 +1,92 @@
+package ast
+
+import "strings"
+
+// Attributes resolves the attributes of components declared using mj-attributes in the mj-head of a document
+type Attributes struct {
+	all     map[string]string
+	tags    map[string]map[string]string
+	classes map[string]map[string]string
+}
+
+// NewAttributes collects the mj-all, mj-class and component elements of the mj-attributes of the document at root.
+// Later declarations take precedence.
+func NewAttributes(root *Node) *Attributes {
+	a := &Attributes{
+		all:     map[string]string{},
+		tags:    map[string]map[string]string{},
+		classes: map[string]map[string]string{},
+	}
+
+	head := root.Child("mj-head")
+
+	if head == nil {
+		return a
+	}
+
+	for _, mjAttributes := range head.FindAll("mj-attributes") {
+		for _, child := range mjAttributes.Children {
+			if child.Type != ElementNode {
+				continue
+			}
+
+			var target map[string]string
+
+			switch child.Tag {
+			case "mj-all":
+				target = a.all
+
+			case "mj-class":
+				name, _ := child.Attr("name")
+				target = lookup(a.classes, name)
+
+			default:
+				target = lookup(a.tags, child.Tag)
+			}
+
+			for _, attr := range child.Attributes {
+				if child.Tag != "mj-class" || attr.Name != "name" {
+					target[attr.Name] = attr.Value
+				}
+			}
+		}
+	}
+
+	return a
+}
+
+// Get returns the value of an attribute of a component and whether it is set. Attributes set on the component take
+// precedence over those of its mj-class, which take precedence over those declared for its tag and then mj-all.
+// The defaults of components are not included.
+func (a *Attributes) Get(n *Node, name string) (string, bool) {
+	if value, ok := n.Attr(name); ok {
+		return value, true
+	}
+
+	if classes, ok := n.Attr("mj-class"); ok {
+		fields := strings.Fields(classes)
+
+		// later classes take precedence
+		for i := len(fields) - 1; i >= 0; i-- {
+			if value, ok := a.classes[fields[i]][name]; ok {
+				return value, true
+			}
+		}
+	}
+
+	if value, ok := a.tags[n.Tag][name]; ok {
+		return value, true
+	}
+
+	value, ok := a.all[name]
+
+	return value, ok
+}
+
+func lookup(m map[string]map[string]string, key string) map[string]string {
+	if _, ok := m[key]; !ok {
+		m[key] = map[string]string{}
+	}
+
+	return m[key]
+}
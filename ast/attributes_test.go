@@ -0,0 +1,60 @@
+package ast
+
+import "testing"
+
+func TestAttributes(t *testing.T) {
+
+	root, err := Parse(`<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-all color="#111111" font-size="12px" />
+      <mj-text color="#222222" />
+      <mj-class name="blue" color="#0000ff" padding="0" />
+      <mj-class name="red" color="#ff0000" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body>
+    <mj-text>Tag</mj-text>
+    <mj-text mj-class="red blue">Class</mj-text>
+    <mj-text mj-class="blue" color="#333333">Own</mj-text>
+    <mj-button>All</mj-button>
+  </mj-body>
+</mjml>`)
+
+	if err != nil {
+		t.Fatalf("Error parsing mjml: %s", err)
+	}
+
+	attributes := NewAttributes(root)
+	nodes := root.Child("mj-body").Children
+
+	var elements []*Node
+
+	for _, n := range nodes {
+		if n.Type == ElementNode {
+			elements = append(elements, n)
+		}
+	}
+
+	tests := []struct {
+		node     *Node
+		name     string
+		expected string
+		ok       bool
+	}{
+		{elements[0], "color", "#222222", true},
+		{elements[1], "color", "#0000ff", true},
+		{elements[1], "padding", "0", true},
+		{elements[2], "color", "#333333", true},
+		{elements[3], "color", "#111111", true},
+		{elements[3], "font-size", "12px", true},
+		{elements[3], "name", "", false},
+		{elements[3], "background-color", "", false},
+	}
+
+	for _, test := range tests {
+		if value, ok := attributes.Get(test.node, test.name); value != test.expected || ok != test.ok {
+			t.Errorf("Expected %s of %s to be %q (%t), got %q (%t)", test.name, test.node.Content, test.expected, test.ok, value, ok)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package ast provides a typed tree representation of MJML documents that can be parsed from, and rendered back to,
+// MJML source.
+package ast
+
+import "strings"
+
+type NodeType int
+
+const (
+	ElementNode NodeType = iota
+	CommentNode
+)
+
+// endingTags are the components whose content is raw HTML or text rather than child components.
+// The list mirrors the components declaring `endingTag = true` in MJML.
+var endingTags = map[string]bool{
+	"mj-accordion-text":  true,
+	"mj-accordion-title": true,
+	"mj-button":          true,
+	"mj-carousel-image":  true,
+	"mj-html-attribute":  true,
+	"mj-navbar-link":     true,
+	"mj-preview":         true,
+	"mj-raw":             true,
+	"mj-social-element":  true,
+	"mj-style":           true,
+	"mj-table":           true,
+	"mj-text":            true,
+	"mj-title":           true,
+}
+
+// IsEndingTag reports whether the content of tag is kept verbatim instead of being parsed into child nodes
+func IsEndingTag(tag string) bool {
+	return endingTags[tag]
+}
+
+type Attribute struct {
+	Name  string
+	Value string
+}
+
+// Node is an element or a comment in an MJML document.
+// Attribute values and content are stored exactly as they appear in the source, as MJML does not decode entities.
+type Node struct {
+	Type       NodeType
+	Tag        string
+	Attributes []Attribute
+	Children   []*Node
+
+	// Content holds the raw content of ending tags such as mj-text, or the text of a comment
+	Content string
+
+	// Line is the line in the source where the node starts, or 0 if the node was not parsed from source
+	Line int
+}
+
+// NewElement creates an element node
+func NewElement(tag string, attributes ...Attribute) *Node {
+	return &Node{
+		Type:       ElementNode,
+		Tag:        tag,
+		Attributes: attributes,
+	}
+}
+
+// NewComment creates a comment node
+func NewComment(text string) *Node {
+	return &Node{
+		Type:    CommentNode,
+		Content: text,
+	}
+}
+
+// Attr returns the value of the named attribute and whether it is set
+func (n *Node) Attr(name string) (string, bool) {
+	for _, attr := range n.Attributes {
+		if attr.Name == name {
+			return attr.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// SetAttr sets the value of the named attribute, keeping its position if it is already set
+func (n *Node) SetAttr(name string, value string) {
+	for i, attr := range n.Attributes {
+		if attr.Name == name {
+			n.Attributes[i].Value = value
+			return
+		}
+	}
+
+	n.Attributes = append(n.Attributes, Attribute{Name: name, Value: value})
+}
+
+// RemoveAttr removes the named attribute if it is set
+func (n *Node) RemoveAttr(name string) {
+	for i, attr := range n.Attributes {
+		if attr.Name == name {
+			n.Attributes = append(n.Attributes[:i:i], n.Attributes[i+1:]...)
+			return
+		}
+	}
+}
+
+// AppendChild adds children after the existing children of the node
+func (n *Node) AppendChild(children ...*Node) {
+	n.Children = append(n.Children, children...)
+}
+
+// PrependChild adds children before the existing children of the node
+func (n *Node) PrependChild(children ...*Node) {
+	n.Children = append(append([]*Node{}, children...), n.Children...)
+}
+
+// RemoveChild removes child from the children of the node
+func (n *Node) RemoveChild(child *Node) {
+	for i, c := range n.Children {
+		if c == child {
+			n.Children = append(n.Children[:i:i], n.Children[i+1:]...)
+			return
+		}
+	}
+}
+
+// Child returns the first direct child element with the given tag or nil if there is none
+func (n *Node) Child(tag string) *Node {
+	for _, child := range n.Children {
+		if child.Type == ElementNode && child.Tag == tag {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// FindAll returns all descendant elements with the given tag in document order
+func (n *Node) FindAll(tag string) []*Node {
+	var found []*Node
+
+	n.Walk(func(node *Node) bool {
+		if node != n && node.Type == ElementNode && node.Tag == tag {
+			found = append(found, node)
+		}
+		return true
+	})
+
+	return found
+}
+
+// Walk calls fn for the node and its descendants in document order.
+// The children of a node are skipped if fn returns false.
+func (n *Node) Walk(fn func(*Node) bool) {
+	if !fn(n) {
+		return
+	}
+
+	for _, child := range n.Children {
+		child.Walk(fn)
+	}
+}
+
+// Clone returns a deep copy of the node
+func (n *Node) Clone() *Node {
+	clone := *n
+
+	if n.Attributes != nil {
+		clone.Attributes = append([]Attribute{}, n.Attributes...)
+	}
+
+	if n.Children != nil {
+		clone.Children = make([]*Node, len(n.Children))
+
+		for i, child := range n.Children {
+			clone.Children[i] = child.Clone()
+		}
+	}
+
+	return &clone
+}
+
+// String returns the MJML source of the node
+func (n *Node) String() string {
+	var sb strings.Builder
+	_ = n.Render(&sb) // strings.Builder never returns an error
+	return sb.String()
+}
@@ -0,0 +1,89 @@
+package ast
+
+import "testing"
+
+func TestNodeAttributes(t *testing.T) {
+
+	node := NewElement("mj-button", Attribute{Name: "href", Value: "https://example.com"})
+
+	node.SetAttr("color", "#fff")
+	node.SetAttr("href", "https://example.com/?a=1&b=2")
+
+	if value, ok := node.Attr("href"); !ok || value != "https://example.com/?a=1&b=2" {
+		t.Errorf("Unexpected href attribute: %q", value)
+	}
+
+	node.RemoveAttr("href")
+
+	if _, ok := node.Attr("href"); ok {
+		t.Error("Expected href attribute to be removed")
+	}
+
+	if len(node.Attributes) != 1 || node.Attributes[0].Name != "color" {
+		t.Errorf("Unexpected attributes: %v", node.Attributes)
+	}
+}
+
+func TestNodeChildren(t *testing.T) {
+
+	root := NewElement("mjml")
+	body := NewElement("mj-body")
+	head := NewElement("mj-head")
+
+	root.AppendChild(body)
+	root.PrependChild(head)
+
+	if root.Child("mj-head") != head || root.Children[1] != body {
+		t.Fatal("Children are not in the expected order")
+	}
+
+	section := NewElement("mj-section")
+	column := NewElement("mj-column")
+	text := NewElement("mj-text")
+	text.Content = "Hello"
+
+	column.AppendChild(text)
+	section.AppendChild(column)
+	body.AppendChild(section)
+
+	if found := root.FindAll("mj-text"); len(found) != 1 || found[0] != text {
+		t.Errorf("Unexpected mj-text nodes: %v", found)
+	}
+
+	clone := root.Clone()
+	clone.FindAll("mj-text")[0].Content = "Changed"
+
+	if text.Content != "Hello" {
+		t.Error("Modifying a clone changed the original node")
+	}
+
+	root.RemoveChild(head)
+
+	if root.Child("mj-head") != nil {
+		t.Error("Expected mj-head to be removed")
+	}
+}
+
+func TestNodeString(t *testing.T) {
+
+	root := NewElement("mjml")
+	body := NewElement("mj-body")
+	text := NewElement("mj-text", Attribute{Name: "title", Value: `Say "Hello"`})
+	text.Content = "<p>Hello</p>"
+
+	body.AppendChild(NewComment(" Greeting "), text, NewElement("mj-spacer"))
+	root.AppendChild(body)
+
+	expected := `<mjml>
+  <mj-body>
+    <!-- Greeting -->
+    <mj-text title="Say &quot;Hello&quot;"><p>Hello</p></mj-text>
+    <mj-spacer />
+  </mj-body>
+</mjml>
+`
+
+	if root.String() != expected {
+		t.Errorf("Rendered MJML does not match expected MJML:\n%s", root.String())
+	}
+}
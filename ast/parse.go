@@ -0,0 +1,298 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SyntaxError is returned when MJML source cannot be parsed
+type SyntaxError struct {
+	Line    int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Parse parses an MJML document and returns its root element
+func Parse(src string) (*Node, error) {
+	nodes, err := ParseFragment(src)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var root *Node
+
+	for _, node := range nodes {
+		if node.Type != ElementNode {
+			continue
+		}
+
+		if root != nil {
+			return nil, &SyntaxError{Line: node.Line, Message: "document has more than one root element"}
+		}
+
+		root = node
+	}
+
+	if root == nil {
+		return nil, &SyntaxError{Line: 1, Message: "document has no root element"}
+	}
+
+	return root, nil
+}
+
+// ParseFragment parses MJML source that may contain any number of top-level nodes, such as a file included with
+// mj-include
+func ParseFragment(src string) ([]*Node, error) {
+	p := parser{src: src, line: 1}
+	return p.parse()
+}
+
+type parser struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (p *parser) parse() ([]*Node, error) {
+	root := &Node{}
+	stack := []*Node{root}
+
+	for p.pos < len(p.src) {
+		current := stack[len(stack)-1]
+
+		if p.src[p.pos] != '<' {
+			next := strings.IndexByte(p.src[p.pos:], '<')
+
+			if next < 0 {
+				next = len(p.src) - p.pos
+			}
+
+			if text := strings.TrimSpace(p.advance(next)); text != "" && current != root {
+				current.Content += text
+			}
+
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(p.src[p.pos:], "<!--"):
+			line := p.line
+			p.advance(4)
+
+			end := strings.Index(p.src[p.pos:], "-->")
+
+			if end < 0 {
+				return nil, &SyntaxError{Line: line, Message: "unterminated comment"}
+			}
+
+			comment := NewComment(p.advance(end))
+			comment.Line = line
+			current.AppendChild(comment)
+
+			p.advance(3)
+
+		case strings.HasPrefix(p.src[p.pos:], "<?"), strings.HasPrefix(p.src[p.pos:], "<!"):
+			end := strings.IndexByte(p.src[p.pos:], '>')
+
+			if end < 0 {
+				return nil, &SyntaxError{Line: p.line, Message: "unterminated declaration"}
+			}
+
+			p.advance(end + 1)
+
+		case strings.HasPrefix(p.src[p.pos:], "</"):
+			line := p.line
+			p.advance(2)
+
+			end := strings.IndexByte(p.src[p.pos:], '>')
+
+			if end < 0 {
+				return nil, &SyntaxError{Line: line, Message: "unterminated closing tag"}
+			}
+
+			tag := strings.TrimSpace(p.advance(end))
+			p.advance(1)
+
+			if current == root || current.Tag != tag {
+				return nil, &SyntaxError{Line: line, Message: fmt.Sprintf("unexpected closing tag </%s>", tag)}
+			}
+
+			stack = stack[:len(stack)-1]
+
+		default:
+			node, selfClosing, err := p.parseStartTag()
+
+			if err != nil {
+				return nil, err
+			}
+
+			current.AppendChild(node)
+
+			if selfClosing {
+				continue
+			}
+
+			if IsEndingTag(node.Tag) {
+				if err := p.parseRawContent(node); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			stack = append(stack, node)
+		}
+	}
+
+	if len(stack) > 1 {
+		unclosed := stack[len(stack)-1]
+		return nil, &SyntaxError{Line: unclosed.Line, Message: fmt.Sprintf("unclosed tag <%s>", unclosed.Tag)}
+	}
+
+	return root.Children, nil
+}
+
+func (p *parser) parseStartTag() (*Node, bool, error) {
+	node := NewElement("")
+	node.Line = p.line
+
+	p.advance(1)
+
+	nameEnd := strings.IndexAny(p.src[p.pos:], " \t\r\n/>")
+
+	if nameEnd <= 0 {
+		return nil, false, &SyntaxError{Line: node.Line, Message: "invalid tag"}
+	}
+
+	node.Tag = p.advance(nameEnd)
+
+	for {
+		p.skipSpace()
+
+		if p.pos >= len(p.src) {
+			return nil, false, &SyntaxError{Line: node.Line, Message: fmt.Sprintf("unterminated tag <%s>", node.Tag)}
+		}
+
+		switch {
+		case p.src[p.pos] == '>':
+			p.advance(1)
+			return node, false, nil
+
+		case strings.HasPrefix(p.src[p.pos:], "/>"):
+			p.advance(2)
+			return node, true, nil
+		}
+
+		attr, err := p.parseAttribute()
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		node.Attributes = append(node.Attributes, attr)
+	}
+}
+
+func (p *parser) parseAttribute() (Attribute, error) {
+	line := p.line
+
+	nameEnd := strings.IndexAny(p.src[p.pos:], " \t\r\n=/>")
+
+	if nameEnd < 0 {
+		nameEnd = len(p.src) - p.pos
+	}
+
+	if nameEnd == 0 {
+		return Attribute{}, &SyntaxError{Line: line, Message: fmt.Sprintf("unexpected character %q in tag", p.src[p.pos])}
+	}
+
+	attr := Attribute{Name: p.advance(nameEnd)}
+
+	p.skipSpace()
+
+	if p.pos >= len(p.src) || p.src[p.pos] != '=' {
+		return attr, nil
+	}
+
+	p.advance(1)
+	p.skipSpace()
+
+	if p.pos >= len(p.src) {
+		return Attribute{}, &SyntaxError{Line: line, Message: fmt.Sprintf("missing value for attribute %s", attr.Name)}
+	}
+
+	if quote := p.src[p.pos]; quote == '"' || quote == '\'' {
+		p.advance(1)
+
+		end := strings.IndexByte(p.src[p.pos:], quote)
+
+		if end < 0 {
+			return Attribute{}, &SyntaxError{Line: line, Message: fmt.Sprintf("unterminated value for attribute %s", attr.Name)}
+		}
+
+		attr.Value = p.advance(end)
+		p.advance(1)
+
+		return attr, nil
+	}
+
+	end := strings.IndexAny(p.src[p.pos:], " \t\r\n>")
+
+	if end < 0 {
+		end = len(p.src) - p.pos
+	}
+
+	attr.Value = p.advance(end)
+
+	// Leave the slash of a self-closing tag such as <mj-image src=image.png/> to be consumed with the >
+	if strings.HasSuffix(attr.Value, "/") && strings.HasPrefix(p.src[p.pos:], ">") {
+		attr.Value = strings.TrimSuffix(attr.Value, "/")
+		p.pos--
+	}
+
+	return attr, nil
+}
+
+func (p *parser) parseRawContent(node *Node) error {
+	closing := "</" + node.Tag
+
+	for offset := 0; ; {
+		idx := strings.Index(p.src[p.pos+offset:], closing)
+
+		if idx < 0 {
+			return &SyntaxError{Line: node.Line, Message: fmt.Sprintf("unclosed tag <%s>", node.Tag)}
+		}
+
+		end := p.pos + offset + idx + len(closing)
+		rest := strings.TrimLeft(p.src[end:], " \t\r\n")
+
+		if strings.HasPrefix(rest, ">") {
+			node.Content = p.advance(offset + idx)
+			p.advance(len(p.src) - len(rest) - p.pos + 1)
+			return nil
+		}
+
+		offset += idx + len(closing)
+	}
+}
+
+func (p *parser) skipSpace() {
+	n := 0
+
+	for p.pos+n < len(p.src) && strings.IndexByte(" \t\r\n", p.src[p.pos+n]) >= 0 {
+		n++
+	}
+
+	p.advance(n)
+}
+
+// advance consumes n bytes of the source, keeping track of the current line
+func (p *parser) advance(n int) string {
+	s := p.src[p.pos : p.pos+n]
+	p.line += strings.Count(s, "\n")
+	p.pos += n
+	return s
+}
@@ -0,0 +1,152 @@
+package ast
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	input := `<?xml version="1.0"?>
+<mjml lang="en">
+  <mj-head>
+    <mj-title>Hello</mj-title>
+  </mj-head>
+  <mj-body>
+    <!-- A comment -->
+    <mj-section background-color='#fff'>
+      <mj-column>
+        <mj-image src=image.png/>
+        <mj-text color="#000"><p>Hello <b>World</b></p></mj-text>
+        <mj-raw><div>Raw</div ></mj-raw >
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	root, err := Parse(input)
+
+	if err != nil {
+		t.Fatalf("Error parsing mjml: %s", err)
+	}
+
+	expected := &Node{
+		Tag:        "mjml",
+		Attributes: []Attribute{{Name: "lang", Value: "en"}},
+		Line:       2,
+		Children: []*Node{
+			{
+				Tag:  "mj-head",
+				Line: 3,
+				Children: []*Node{
+					{Tag: "mj-title", Content: "Hello", Line: 4},
+				},
+			},
+			{
+				Tag:  "mj-body",
+				Line: 6,
+				Children: []*Node{
+					{Type: CommentNode, Content: " A comment ", Line: 7},
+					{
+						Tag:        "mj-section",
+						Attributes: []Attribute{{Name: "background-color", Value: "#fff"}},
+						Line:       8,
+						Children: []*Node{
+							{
+								Tag:  "mj-column",
+								Line: 9,
+								Children: []*Node{
+									{Tag: "mj-image", Attributes: []Attribute{{Name: "src", Value: "image.png"}}, Line: 10},
+									{Tag: "mj-text", Attributes: []Attribute{{Name: "color", Value: "#000"}}, Content: "<p>Hello <b>World</b></p>", Line: 11},
+									{Tag: "mj-raw", Content: "<div>Raw</div >", Line: 12},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(root, expected) {
+		t.Errorf("Parsed tree does not match expected tree:\n%s", root)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+
+	tests := map[string]struct {
+		input string
+		line  int
+	}{
+		"mismatched closing tag": {input: "<mjml>\n<mj-body></mj-head>\n</mjml>", line: 2},
+		"unclosed tag":           {input: "<mjml>\n<mj-body>\n", line: 2},
+		"unclosed ending tag":    {input: "<mjml>\n<mj-text>Hello\n</mjml>", line: 2},
+		"unterminated comment":   {input: "<mjml>\n\n<!-- Hello</mjml>", line: 3},
+		"multiple roots":         {input: "<mjml></mjml>\n<mjml></mjml>", line: 2},
+		"no root":                {input: "<!-- Hello -->", line: 1},
+	}
+
+	for name, test := range tests {
+		_, err := Parse(test.input)
+
+		var syntaxErr *SyntaxError
+
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Expected a syntax error for %s, got: %v", name, err)
+			continue
+		}
+
+		if syntaxErr.Line != test.line {
+			t.Errorf("Expected syntax error for %s on line %d, got line %d", name, test.line, syntaxErr.Line)
+		}
+	}
+}
+
+func TestParseRenderRoundTrip(t *testing.T) {
+
+	files := []string{
+		"black-friday",
+		"one-page",
+		"reactivation-email",
+		"real-estate",
+		"recast",
+		"receipt-email",
+	}
+
+	for _, file := range files {
+		contents, err := os.ReadFile("../testdata/" + file + ".mjml")
+
+		if err != nil {
+			t.Fatalf("Error reading file: %s", file)
+		}
+
+		root, err := Parse(string(contents))
+
+		if err != nil {
+			t.Fatalf("Error parsing %s: %s", file, err)
+		}
+
+		reparsed, err := Parse(root.String())
+
+		if err != nil {
+			t.Fatalf("Error parsing rendered %s: %s", file, err)
+		}
+
+		clearLines(root)
+		clearLines(reparsed)
+
+		if !reflect.DeepEqual(root, reparsed) {
+			t.Errorf("Rendered tree for %s does not match parsed tree", file)
+		}
+	}
+}
+
+func clearLines(n *Node) {
+	n.Walk(func(node *Node) bool {
+		node.Line = 0
+		return true
+	})
+}
@@ -0,0 +1,102 @@
+package ast
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// LineMap maps lines in rendered MJML to the lines in the original source where the nodes starting on them were parsed
+type LineMap map[int]int
+
+// Render writes the MJML source of the node and its descendants to w
+func (n *Node) Render(w io.Writer) error {
+	_, err := n.RenderWithLineMap(w)
+	return err
+}
+
+// RenderWithLineMap writes the MJML source of the node and its descendants to w and returns a LineMap that can be used
+// to translate lines reported for the rendered MJML back to the source. Nodes that were not parsed from source are
+// mapped to line 0.
+func (n *Node) RenderWithLineMap(w io.Writer) (LineMap, error) {
+	r := renderer{
+		w:       bufio.NewWriter(w),
+		line:    1,
+		lineMap: LineMap{},
+	}
+
+	r.render(n, 0)
+
+	return r.lineMap, r.w.Flush()
+}
+
+type renderer struct {
+	w       *bufio.Writer
+	line    int
+	lineMap LineMap
+}
+
+func (r *renderer) render(n *Node, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	w := r.w
+
+	r.lineMap[r.line] = n.Line
+
+	w.WriteString(indent)
+
+	if n.Type == CommentNode {
+		w.WriteString("<!--")
+		w.WriteString(n.Content)
+		w.WriteString("-->\n")
+		r.line += strings.Count(n.Content, "\n") + 1
+		return
+	}
+
+	w.WriteString("<")
+	w.WriteString(n.Tag)
+
+	for _, attr := range n.Attributes {
+		w.WriteString(" ")
+		w.WriteString(attr.Name)
+		w.WriteString(`="`)
+		w.WriteString(strings.ReplaceAll(attr.Value, `"`, "&quot;"))
+		r.line += strings.Count(attr.Value, "\n")
+		w.WriteString(`"`)
+	}
+
+	if len(n.Children) == 0 && n.Content == "" {
+		w.WriteString(" />\n")
+		r.line++
+		return
+	}
+
+	w.WriteString(">")
+
+	if IsEndingTag(n.Tag) {
+		w.WriteString(n.Content)
+		r.line += strings.Count(n.Content, "\n")
+	} else {
+		w.WriteString("\n")
+		r.line++
+
+		if n.Content != "" {
+			w.WriteString(indent)
+			w.WriteString("  ")
+			w.WriteString(n.Content)
+			w.WriteString("\n")
+			r.line += strings.Count(n.Content, "\n") + 1
+		}
+
+		for _, child := range n.Children {
+			r.render(child, depth+1)
+		}
+
+		w.WriteString(indent)
+	}
+
+	w.WriteString("</")
+	w.WriteString(n.Tag)
+	w.WriteString(">\n")
+	r.line++
+}
@@ -0,0 +1,61 @@
+package ast
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderWithLineMap(t *testing.T) {
+
+	input := `<mjml>
+
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text>
+          Multiple
+          lines
+        </mj-text>
+
+
+        <mj-button>Button</mj-button>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	root, err := Parse(input)
+
+	if err != nil {
+		t.Fatalf("Error parsing mjml: %s", err)
+	}
+
+	root.PrependChild(NewElement("mj-head"))
+
+	var sb strings.Builder
+
+	lineMap, err := root.RenderWithLineMap(&sb)
+
+	if err != nil {
+		t.Fatalf("Error rendering mjml: %s", err)
+	}
+
+	expected := LineMap{
+		1:  1,
+		2:  0,
+		3:  3,
+		4:  4,
+		5:  5,
+		6:  6,
+		10: 12,
+	}
+
+	if !reflect.DeepEqual(lineMap, expected) {
+		t.Errorf("Line map does not match expected line map: %v", lineMap)
+	}
+
+	if lines := strings.Split(sb.String(), "\n"); !strings.Contains(lines[9], "<mj-button>") {
+		t.Errorf("Expected mj-button on line 10, got: %q", lines[9])
+	}
+}
@@ -1,5 +1,7 @@
 package mjml
 
+import "maps"
+
 type BeautifyBraceStyle string
 
 const (
@@ -71,122 +73,127 @@ type beautifyOptions struct {
 	data map[string]interface{}
 }
 
+// clone copies the js-beautify options for setters to modify
+func (o *beautifyOptions) clone() beautifyOptions {
+	return beautifyOptions{data: maps.Clone(o.data)}
+}
+
 func (o *beautifyOptions) IndentSize(indentSize uint) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_size"] = indentSize
 	return &ret
 }
 
 func (o *beautifyOptions) IndentChar(character string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_char"] = character
 	return &ret
 }
 
 func (o *beautifyOptions) IndentWithTabs(b bool) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_with_tabs"] = b
 	return &ret
 }
 
 func (o *beautifyOptions) Eol(string string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["eol"] = string
 	return &ret
 }
 
 func (o *beautifyOptions) EndWithNewline(b bool) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["end_with_newline"] = b
 	return &ret
 }
 
 func (o *beautifyOptions) PreserveNewlines(b bool) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserve_newlines"] = b
 	return &ret
 }
 
 func (o *beautifyOptions) MaxPreserveNewlines(max uint) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["max_preserve_newlines"] = max
 	return &ret
 }
 
 func (o *beautifyOptions) IndentInnerHtml(b bool) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_inner_html"] = b
 	return &ret
 }
 
 func (o *beautifyOptions) BraceStyle(braceStyle BeautifyBraceStyle) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["brace_style"] = braceStyle
 	return &ret
 }
 
 func (o *beautifyOptions) IndentScripts(indentScripts BeautifyIndentScripts) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_scripts"] = indentScripts
 	return &ret
 }
 
 func (o *beautifyOptions) WrapLineLength(lineLength uint) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["wrap_line_length"] = lineLength
 	return &ret
 }
 
 func (o *beautifyOptions) WrapAttributes(wrapAttributes BeautifyWrapAttributes) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["wrap_attributes"] = wrapAttributes
 	return &ret
 }
 
 func (o *beautifyOptions) WrapAttributesIndentSize(indentSize uint) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["wrap_attributes_indent_size"] = indentSize
 	return &ret
 }
 
 func (o *beautifyOptions) Inline(tags []string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["inline"] = tags
 	return &ret
 }
 
 func (o *beautifyOptions) Unformatted(tags []string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["unformatted"] = tags
 	return &ret
 }
 
 func (o *beautifyOptions) ContentUnformatted(tags []string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["content_unformatted"] = tags
 	return &ret
 }
 
 func (o *beautifyOptions) ExtraLiners(tags []string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["extra_liners"] = tags
 	return &ret
 }
 
 func (o *beautifyOptions) UnformattedContentDelimiter(string string) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["unformatted_content_delimiter"] = string
 	return &ret
 }
 
 func (o *beautifyOptions) IndentEmptyLines(b bool) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["indent_empty_lines"] = b
 	return &ret
 }
 
 func (o *beautifyOptions) Templating(templating []BeautifyTemplating) BeautifyOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["templating"] = templating
 	return &ret
 }
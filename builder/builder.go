@@ -0,0 +1,108 @@
+// Package builder provides a fluent API to construct MJML documents in Go.
+//
+// Each MJML component has a constructor and a type with a setter for every attribute the component allows, so using
+// an attribute that is not valid for a component is caught at compile time:
+//
+//	doc := builder.Document(
+//		builder.Body(
+//			builder.Section(
+//				builder.Column(
+//					builder.Text("Hello World").Color("#F45E43").FontSize("20px"),
+//					builder.Button("Visit us").Href("https://example.com"),
+//				),
+//			),
+//		),
+//	)
+//
+//	output, err := mjml.ToHTML(ctx, doc.String())
+package builder
+
+//go:generate go run gen.go
+
+import "github.com/Boostport/mjml-go/ast"
+
+// Component is implemented by all component builders
+type Component interface {
+	Node() *ast.Node
+}
+
+func newContainer(tag string, children []Component) *ast.Node {
+	node := ast.NewElement(tag)
+	appendChildren(node, children)
+	return node
+}
+
+func newEnding(tag string, content string) *ast.Node {
+	node := ast.NewElement(tag)
+	node.Content = content
+	return node
+}
+
+func appendChildren(node *ast.Node, children []Component) {
+	for _, child := range children {
+		if child != nil {
+			node.AppendChild(child.Node())
+		}
+	}
+}
+
+// Attr sets a default attribute for all components
+func (c *MJAll) Attr(name string, value string) *MJAll {
+	c.node.SetAttr(name, value)
+	return c
+}
+
+// Class creates an mj-class definition that can be applied to components using their MJClass setter
+func Class(name string) *MJClass {
+	return &MJClass{node: ast.NewElement("mj-class", ast.Attribute{Name: "name", Value: name})}
+}
+
+// Attr sets an attribute applied by the class
+func (c *MJClass) Attr(name string, value string) *MJClass {
+	c.node.SetAttr(name, value)
+	return c
+}
+
+// Breakpoint creates an mj-breakpoint component with the given width
+func Breakpoint(width string) *MJBreakpoint {
+	return &MJBreakpoint{node: ast.NewElement("mj-breakpoint", ast.Attribute{Name: "width", Value: width})}
+}
+
+// Font creates an mj-font component importing the font hosted at href
+func Font(name string, href string) *MJFont {
+	return &MJFont{node: ast.NewElement("mj-font", ast.Attribute{Name: "name", Value: name}, ast.Attribute{Name: "href", Value: href})}
+}
+
+// Selector creates an mj-selector component applying attributes to the elements matching the CSS selector path
+func Selector(path string, attributes ...*MJHTMLAttribute) *MJSelector {
+	selector := &MJSelector{node: ast.NewElement("mj-selector", ast.Attribute{Name: "path", Value: path})}
+
+	for _, attribute := range attributes {
+		selector.node.AppendChild(attribute.node)
+	}
+
+	return selector
+}
+
+// HTMLAttribute creates an mj-html-attribute component
+func HTMLAttribute(name string, value string) *MJHTMLAttribute {
+	attribute := &MJHTMLAttribute{node: ast.NewElement("mj-html-attribute", ast.Attribute{Name: "name", Value: name})}
+	attribute.node.Content = value
+	return attribute
+}
+
+// Include creates an mj-include component including the file at path
+func Include(path string) *MJInclude {
+	return &MJInclude{node: ast.NewElement("mj-include", ast.Attribute{Name: "path", Value: path})}
+}
+
+// Inline sets whether the styles should be inlined
+func (c *MJStyle) Inline(inline bool) *MJStyle {
+	if inline {
+		c.node.SetAttr("inline", "inline")
+	} else {
+		c.node.RemoveAttr("inline")
+	}
+
+	return c
+}
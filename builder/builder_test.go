@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Boostport/mjml-go"
+)
+
+func TestBuilder(t *testing.T) {
+
+	doc := Document(
+		Head(
+			Title("Welcome"),
+			Preview("Thanks for signing up"),
+			Breakpoint("480px"),
+			Font("Raleway", "https://fonts.googleapis.com/css?family=Raleway"),
+			Attributes(
+				All().Attr("font-family", "Raleway, Arial"),
+				Class("blue").Attr("color", "#0000ff"),
+				Text("").Color("#333333"),
+			),
+			Style(".link { color: red; }").Inline(true),
+			HTMLAttributes(
+				Selector(".custom div", HTMLAttribute("data-id", "42")),
+			),
+		),
+		Body(
+			Section(
+				Column(
+					Image().Src("https://example.com/logo.png").Alt("Logo").Width("100px"),
+					Text("<p>Hello World</p>").MJClass("blue").FontSize("20px"),
+					Button("Get started").Href("https://example.com/?a=1&b=2").BackgroundColor("#F45E43"),
+				).Width("100%"),
+			).BackgroundColor("#ffffff"),
+		).Width("600px"),
+	).Lang("en")
+
+	expected := `<mjml lang="en">
+  <mj-head>
+    <mj-title>Welcome</mj-title>
+    <mj-preview>Thanks for signing up</mj-preview>
+    <mj-breakpoint width="480px" />
+    <mj-font name="Raleway" href="https://fonts.googleapis.com/css?family=Raleway" />
+    <mj-attributes>
+      <mj-all font-family="Raleway, Arial" />
+      <mj-class name="blue" color="#0000ff" />
+      <mj-text color="#333333" />
+    </mj-attributes>
+    <mj-style inline="inline">.link { color: red; }</mj-style>
+    <mj-html-attributes>
+      <mj-selector path=".custom div">
+        <mj-html-attribute name="data-id">42</mj-html-attribute>
+      </mj-selector>
+    </mj-html-attributes>
+  </mj-head>
+  <mj-body width="600px">
+    <mj-section background-color="#ffffff">
+      <mj-column width="100%">
+        <mj-image src="https://example.com/logo.png" alt="Logo" width="100px" />
+        <mj-text mj-class="blue" font-size="20px"><p>Hello World</p></mj-text>
+        <mj-button href="https://example.com/?a=1&b=2" background-color="#F45E43">Get started</mj-button>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>
+`
+
+	if doc.String() != expected {
+		t.Fatalf("Built MJML does not match expected MJML:\n%s", doc.String())
+	}
+
+	_, err := mjml.ToHTML(context.Background(), doc.String(), mjml.WithValidationLevel(mjml.Strict))
+
+	if err != nil {
+		t.Errorf("Error converting built mjml to html: %s", err)
+	}
+}
+
+func TestBuilderAdd(t *testing.T) {
+
+	section := Section()
+
+	for _, label := range []string{"One", "Two"} {
+		section.Add(Column(Text(label)))
+	}
+
+	section.Add(nil)
+
+	if columns := section.Node().FindAll("mj-column"); len(columns) != 2 {
+		t.Errorf("Expected 2 columns, got %d", len(columns))
+	}
+}
@@ -0,0 +1,247 @@
+//go:build ignore
+
+// This program generates components.go. It can be invoked by running go generate.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+type kind int
+
+const (
+	container kind = iota // has child components
+	ending                // has raw content
+	void                  // has neither children nor content
+)
+
+type component struct {
+	Tag  string
+	Type string
+	Kind kind
+
+	// Func is the name of the generated constructor, or empty if the constructor is written by hand
+	Func       string
+	Attributes []string
+}
+
+var (
+	global  = []string{"css-class", "mj-class"}
+	padding = []string{"padding", "padding-top", "padding-right", "padding-bottom", "padding-left"}
+	borders = []string{"border", "border-top", "border-right", "border-bottom", "border-left"}
+)
+
+func attrs(groups ...[]string) []string {
+	var result []string
+
+	for _, group := range groups {
+		result = append(result, group...)
+	}
+
+	return result
+}
+
+// Allowed attributes are taken from the allowedAttributes of each component in MJML 4
+var components = []component{
+	{Tag: "mjml", Type: "MJML", Kind: container, Func: "Document", Attributes: []string{"dir", "lang", "owa"}},
+
+	// Head components
+	{Tag: "mj-head", Type: "MJHead", Kind: container, Func: "Head"},
+	{Tag: "mj-attributes", Type: "MJAttributes", Kind: container, Func: "Attributes"},
+	{Tag: "mj-all", Type: "MJAll", Kind: void, Func: "All"},
+	{Tag: "mj-class", Type: "MJClass", Kind: void},
+	{Tag: "mj-breakpoint", Type: "MJBreakpoint", Kind: void, Attributes: []string{"width"}},
+	{Tag: "mj-font", Type: "MJFont", Kind: void, Attributes: []string{"href", "name"}},
+	{Tag: "mj-html-attributes", Type: "MJHTMLAttributes", Kind: container, Func: "HTMLAttributes"},
+	{Tag: "mj-selector", Type: "MJSelector", Kind: container, Attributes: []string{"path"}},
+	{Tag: "mj-html-attribute", Type: "MJHTMLAttribute", Kind: ending, Attributes: []string{"name"}},
+	{Tag: "mj-preview", Type: "MJPreview", Kind: ending, Func: "Preview"},
+	{Tag: "mj-style", Type: "MJStyle", Kind: ending, Func: "Style"},
+	{Tag: "mj-title", Type: "MJTitle", Kind: ending, Func: "Title"},
+
+	// Body components
+	{Tag: "mj-body", Type: "MJBody", Kind: container, Func: "Body", Attributes: attrs(global, []string{"background-color", "width"})},
+	{Tag: "mj-include", Type: "MJInclude", Kind: void, Attributes: []string{"css-inline", "path", "type"}},
+	{Tag: "mj-accordion", Type: "MJAccordion", Kind: container, Func: "Accordion", Attributes: attrs(global, padding, []string{
+		"border", "container-background-color", "font-family", "icon-align", "icon-height", "icon-position",
+		"icon-unwrapped-alt", "icon-unwrapped-url", "icon-width", "icon-wrapped-alt", "icon-wrapped-url",
+	})},
+	{Tag: "mj-accordion-element", Type: "MJAccordionElement", Kind: container, Func: "AccordionElement", Attributes: attrs(global, []string{
+		"background-color", "border", "font-family", "icon-align", "icon-height", "icon-position",
+		"icon-unwrapped-alt", "icon-unwrapped-url", "icon-width", "icon-wrapped-alt", "icon-wrapped-url",
+	})},
+	{Tag: "mj-accordion-title", Type: "MJAccordionTitle", Kind: ending, Func: "AccordionTitle", Attributes: attrs(global, padding, []string{
+		"background-color", "color", "font-family", "font-size",
+	})},
+	{Tag: "mj-accordion-text", Type: "MJAccordionText", Kind: ending, Func: "AccordionText", Attributes: attrs(global, padding, []string{
+		"background-color", "color", "font-family", "font-size", "font-weight", "letter-spacing", "line-height",
+	})},
+	{Tag: "mj-button", Type: "MJButton", Kind: ending, Func: "Button", Attributes: attrs(global, padding, borders, []string{
+		"align", "background-color", "border-radius", "color", "container-background-color", "font-family",
+		"font-size", "font-style", "font-weight", "height", "href", "inner-padding", "letter-spacing", "line-height",
+		"name", "rel", "target", "text-align", "text-decoration", "text-transform", "title", "vertical-align", "width",
+	})},
+	{Tag: "mj-carousel", Type: "MJCarousel", Kind: container, Func: "Carousel", Attributes: attrs(global, padding, []string{
+		"align", "border-radius", "container-background-color", "icon-width", "left-icon", "right-icon", "tb-border",
+		"tb-border-radius", "tb-hover-border-color", "tb-selected-border-color", "tb-width", "thumbnails",
+	})},
+	{Tag: "mj-carousel-image", Type: "MJCarouselImage", Kind: void, Func: "CarouselImage", Attributes: attrs(global, []string{
+		"alt", "border-radius", "href", "rel", "src", "target", "tb-border", "tb-border-radius", "thumbnails-src", "title",
+	})},
+	{Tag: "mj-column", Type: "MJColumn", Kind: container, Func: "Column", Attributes: attrs(global, padding, borders, []string{
+		"background-color", "border-radius", "direction", "inner-background-color", "inner-border",
+		"inner-border-bottom", "inner-border-left", "inner-border-radius", "inner-border-right", "inner-border-top",
+		"vertical-align", "width",
+	})},
+	{Tag: "mj-divider", Type: "MJDivider", Kind: void, Func: "Divider", Attributes: attrs(global, padding, []string{
+		"align", "border-color", "border-style", "border-width", "container-background-color", "width",
+	})},
+	{Tag: "mj-group", Type: "MJGroup", Kind: container, Func: "Group", Attributes: attrs(global, []string{
+		"background-color", "direction", "vertical-align", "width",
+	})},
+	{Tag: "mj-hero", Type: "MJHero", Kind: container, Func: "Hero", Attributes: attrs(global, padding, []string{
+		"background-color", "background-height", "background-position", "background-url", "background-width",
+		"border-radius", "container-background-color", "height", "inner-background-color", "inner-padding",
+		"inner-padding-bottom", "inner-padding-left", "inner-padding-right", "inner-padding-top", "mode",
+		"vertical-align",
+	})},
+	{Tag: "mj-image", Type: "MJImage", Kind: void, Func: "Image", Attributes: attrs(global, padding, borders, []string{
+		"align", "alt", "border-radius", "container-background-color", "fluid-on-mobile", "font-size", "height",
+		"href", "max-height", "name", "rel", "sizes", "src", "srcset", "target", "title", "usemap", "width",
+	})},
+	{Tag: "mj-navbar", Type: "MJNavbar", Kind: container, Func: "Navbar", Attributes: attrs(global, padding, []string{
+		"align", "base-url", "hamburger", "ico-align", "ico-close", "ico-color", "ico-font-family", "ico-font-size",
+		"ico-line-height", "ico-open", "ico-padding", "ico-padding-bottom", "ico-padding-left", "ico-padding-right",
+		"ico-padding-top", "ico-text-decoration", "ico-text-transform",
+	})},
+	{Tag: "mj-navbar-link", Type: "MJNavbarLink", Kind: ending, Func: "NavbarLink", Attributes: attrs(global, padding, []string{
+		"color", "font-family", "font-size", "font-style", "font-weight", "href", "letter-spacing", "line-height",
+		"name", "rel", "target", "text-decoration", "text-transform",
+	})},
+	{Tag: "mj-raw", Type: "MJRaw", Kind: ending, Func: "Raw", Attributes: []string{"position"}},
+	{Tag: "mj-section", Type: "MJSection", Kind: container, Func: "Section", Attributes: attrs(global, padding, borders, []string{
+		"background-color", "background-position", "background-position-x", "background-position-y",
+		"background-repeat", "background-size", "background-url", "border-radius", "direction", "full-width",
+		"text-align",
+	})},
+	{Tag: "mj-social", Type: "MJSocial", Kind: container, Func: "Social", Attributes: attrs(global, padding, []string{
+		"align", "border-radius", "color", "container-background-color", "font-family", "font-size", "font-style",
+		"font-weight", "icon-height", "icon-padding", "icon-size", "inner-padding", "line-height", "mode",
+		"table-layout", "text-decoration", "text-padding", "vertical-align",
+	})},
+	{Tag: "mj-social-element", Type: "MJSocialElement", Kind: ending, Func: "SocialElement", Attributes: attrs(global, padding, []string{
+		"align", "alt", "background-color", "border-radius", "color", "font-family", "font-size", "font-style",
+		"font-weight", "href", "icon-height", "icon-padding", "icon-size", "line-height", "name", "rel", "sizes",
+		"src", "srcset", "target", "text-decoration", "text-padding", "title", "vertical-align",
+	})},
+	{Tag: "mj-spacer", Type: "MJSpacer", Kind: void, Func: "Spacer", Attributes: attrs(global, padding, borders, []string{
+		"container-background-color", "height",
+	})},
+	{Tag: "mj-table", Type: "MJTable", Kind: ending, Func: "Table", Attributes: attrs(global, padding, []string{
+		"align", "border", "cellpadding", "cellspacing", "color", "container-background-color", "font-family",
+		"font-size", "font-weight", "line-height", "role", "table-layout", "vertical-align", "width",
+	})},
+	{Tag: "mj-text", Type: "MJText", Kind: ending, Func: "Text", Attributes: attrs(global, padding, []string{
+		"align", "background-color", "color", "container-background-color", "font-family", "font-size",
+		"font-style", "font-weight", "height", "letter-spacing", "line-height", "text-decoration", "text-transform",
+		"vertical-align",
+	})},
+	{Tag: "mj-wrapper", Type: "MJWrapper", Kind: container, Func: "Wrapper", Attributes: attrs(global, padding, borders, []string{
+		"background-color", "background-position", "background-position-x", "background-position-y",
+		"background-repeat", "background-size", "background-url", "border-radius", "direction", "full-width",
+		"text-align",
+	})},
+}
+
+var initialisms = map[string]string{
+	"css":  "CSS",
+	"html": "HTML",
+	"mj":   "MJ",
+	"url":  "URL",
+}
+
+func goName(attribute string) string {
+	var sb strings.Builder
+
+	for _, part := range strings.Split(attribute, "-") {
+		if initialism, ok := initialisms[part]; ok {
+			sb.WriteString(initialism)
+			continue
+		}
+
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return sb.String()
+}
+
+var tmpl = template.Must(template.New("components").Funcs(template.FuncMap{"goName": goName}).Parse(`// Code generated by gen.go; DO NOT EDIT.
+
+package builder
+
+import "github.com/Boostport/mjml-go/ast"
+
+{{range .}}
+// {{.Type}} builds an {{.Tag}} component
+type {{.Type}} struct {
+	node *ast.Node
+}
+{{if .Func}}
+{{if eq .Kind 0}}// {{.Func}} creates an {{.Tag}} component containing children
+func {{.Func}}(children ...Component) *{{.Type}} {
+	return &{{.Type}}{node: newContainer("{{.Tag}}", children)}
+}
+{{else if eq .Kind 1}}// {{.Func}} creates an {{.Tag}} component with content
+func {{.Func}}(content string) *{{.Type}} {
+	return &{{.Type}}{node: newEnding("{{.Tag}}", content)}
+}
+{{else}}// {{.Func}} creates an {{.Tag}} component
+func {{.Func}}() *{{.Type}} {
+	return &{{.Type}}{node: ast.NewElement("{{.Tag}}")}
+}
+{{end}}{{end}}
+// Node returns the node being built
+func (c *{{.Type}}) Node() *ast.Node {
+	return c.node
+}
+
+// String returns the MJML source of the component
+func (c *{{.Type}}) String() string {
+	return c.node.String()
+}
+{{if eq .Kind 0}}
+// Add appends children to the component
+func (c *{{.Type}}) Add(children ...Component) *{{.Type}} {
+	appendChildren(c.node, children)
+	return c
+}
+{{end}}{{$type := .Type}}{{range .Attributes}}
+// {{goName .}} sets the {{.}} attribute
+func (c *{{$type}}) {{goName .}}(value string) *{{$type}} {
+	c.node.SetAttr("{{.}}", value)
+	return c
+}
+{{end}}{{end}}`))
+
+func main() {
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, components); err != nil {
+		log.Fatalf("Error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Fatalf("Error formatting generated code: %s", err)
+	}
+
+	if err := os.WriteFile("components.go", src, 0644); err != nil {
+		log.Fatalf("Error writing components.go: %s", err)
+	}
+}
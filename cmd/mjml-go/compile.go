@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+)
+
+func (c *cli) compileStdin(ctx context.Context, options []mjml.ToHTMLOption) int {
+	input, err := io.ReadAll(c.in)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error reading stdin: %s\n", err)
+		return 1
+	}
+
+	output, err := c.toHTML(ctx, "stdin", string(input), options)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error compiling stdin: %s\n", err)
+		return 1
+	}
+
+	if c.validate {
+		return 0
+	}
+
+	if c.output != "" {
+		if err := os.WriteFile(c.output, []byte(output), 0644); err != nil {
+			fmt.Fprintf(c.err, "Error writing output: %s\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	fmt.Fprint(c.out, output)
+
+	return 0
+}
+
+func (c *cli) compileFiles(ctx context.Context, inputs []string, options []mjml.ToHTMLOption) int {
+	files, err := expandInputs(inputs)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error: %s\n", err)
+		return 1
+	}
+
+	outputDir, err := c.outputDir(files)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error: %s\n", err)
+		return 1
+	}
+
+	status := 0
+
+	for _, file := range files {
+		if err := c.compileFile(ctx, file, outputDir, options); err != nil {
+			fmt.Fprintf(c.err, "Error compiling %s: %s\n", file, err)
+			status = 1
+		}
+	}
+
+	return status
+}
+
+func (c *cli) compileFile(ctx context.Context, file string, outputDir string, options []mjml.ToHTMLOption) error {
+	input, err := os.ReadFile(file)
+
+	if err != nil {
+		return err
+	}
+
+	root, name, err := fileSystem(file)
+
+	if err != nil {
+		return err
+	}
+
+	options = slices.Concat(options, []mjml.ToHTMLOption{mjml.WithIncludeFS(os.DirFS(root)), mjml.WithFilePath(name)})
+
+	output, err := c.toHTML(ctx, file, string(input), options)
+
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c.validate:
+		return nil
+
+	case c.stdout:
+		_, err := fmt.Fprint(c.out, output)
+		return err
+
+	case c.output != "" && outputDir == "":
+		return os.WriteFile(c.output, []byte(output), 0644)
+	}
+
+	return os.WriteFile(outputPath(file, outputDir), []byte(output), 0644)
+}
+
+// toHTML compiles input like the MJML CLI, which writes the output compiled despite validation errors unless the
+// validation level is strict or the input is only validated. The validation errors are printed as warnings. Errors
+// without compiled output, such as include errors, are returned.
+func (c *cli) toHTML(ctx context.Context, name string, input string, options []mjml.ToHTMLOption) (string, error) {
+	output, err := mjml.ToHTML(ctx, input, options...)
+
+	var mjmlError mjml.Error
+
+	if err == nil || c.validate || !errors.As(err, &mjmlError) || mjmlError.HTML == "" ||
+		mjml.NewOptions(options...).ValidationLevel() == mjml.Strict {
+		return output, err
+	}
+
+	fmt.Fprintf(c.err, "Warning compiling %s: %s\n", name, mjmlError)
+
+	return mjmlError.HTML, nil
+}
+
+// outputDir returns the directory the compiled files are written to, or an empty string if the output is a single file
+func (c *cli) outputDir(files []string) (string, error) {
+	if c.output == "" {
+		return ".", nil
+	}
+
+	info, err := os.Stat(c.output)
+
+	switch {
+	case err == nil && info.IsDir():
+		return c.output, nil
+
+	case strings.HasSuffix(c.output, "/") || strings.HasSuffix(c.output, string(filepath.Separator)):
+		return c.output, os.MkdirAll(c.output, 0755)
+
+	case len(files) > 1:
+		return "", fmt.Errorf("output %s must be a directory when compiling multiple files", c.output)
+	}
+
+	return "", nil
+}
+
+// fileSystem returns the root of the file system containing file and the slash-separated path of file within it, so
+// that mj-include tags can be resolved relative to the file, or to the root if they are absolute
+func fileSystem(file string) (string, string, error) {
+	abs, err := filepath.Abs(file)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	volume := filepath.VolumeName(abs)
+	name := strings.TrimPrefix(filepath.ToSlash(abs[len(volume):]), "/")
+
+	return volume + string(filepath.Separator), name, nil
+}
+
+func outputPath(file string, outputDir string) string {
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)) + ".html"
+	return filepath.Join(outputDir, name)
+}
+
+// expandInputs expands globs and directories into the list of mjml files to compile
+func expandInputs(inputs []string) ([]string, error) {
+	var files []string
+
+	for _, input := range inputs {
+		matches, err := filepath.Glob(input)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %s: %w", input, err)
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %s", input)
+		}
+
+		for _, match := range matches {
+			info, err := os.Stat(match)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !info.IsDir() {
+				files = append(files, match)
+				continue
+			}
+
+			dirFiles, err := filepath.Glob(filepath.Join(match, "*.mjml"))
+
+			if err != nil {
+				return nil, err
+			}
+
+			files = append(files, dirFiles...)
+		}
+	}
+
+	return files, nil
+}
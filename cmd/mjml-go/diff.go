@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+	"github.com/Boostport/mjml-go/diff"
+)
+
+// runDiff runs the diff subcommand, which compares the html compiled from two versions of templates, or from the
+// same templates using two configurations. It returns 1 if there are differences, like diff(1).
+func runDiff(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	var (
+		c            config
+		beforeConfig string
+		afterConfig  string
+		summary      bool
+	)
+
+	flags := flag.NewFlagSet("mjml-go diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: mjml-go diff [options] <before> <after>")
+		fmt.Fprintln(stderr, "       mjml-go diff [options] --before.config <path> --after.config <path> <input>")
+		fmt.Fprintln(stderr, "Inputs are .mjml or .html files, or directories containing them, which are paired by name.")
+		flags.PrintDefaults()
+	}
+
+	c.register(flags)
+	flags.StringVar(&beforeConfig, "before.config", "", "Path to a .mjmlconfig file used to compile the before templates")
+	flags.StringVar(&afterConfig, "after.config", "", "Path to a .mjmlconfig file used to compile the after templates")
+	boolFlag(flags, &summary, "Only print the changed components and style properties", "summary")
+
+	inputs, err := parseInterleaved(flags, args)
+
+	if err != nil {
+		return 2
+	}
+
+	// a single input is compared with itself, which requires different configurations
+	if len(inputs) == 0 || len(inputs) > 2 || (len(inputs) == 1 && beforeConfig == "" && afterConfig == "") {
+		flags.Usage()
+		return 2
+	}
+
+	options, err := c.toHTMLOptions()
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	beforeOptions, err := sideOptions(options, beforeConfig)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	afterOptions, err := sideOptions(options, afterConfig)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	before, after := inputs[0], inputs[len(inputs)-1]
+
+	pairs, only, err := pairInputs(before, after)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	status := 0
+
+	for _, file := range only {
+		fmt.Fprintf(stdout, "Only in %s\n", file)
+		status = 1
+	}
+
+	for _, pair := range pairs {
+		d, err := compareFiles(ctx, pair[0], beforeOptions, pair[1], afterOptions)
+
+		if err != nil {
+			fmt.Fprintf(stderr, "Error comparing %s and %s: %s\n", pair[0], pair[1], err)
+			return 2
+		}
+
+		if d.Equal() {
+			continue
+		}
+
+		status = 1
+
+		if err := writeDiff(stdout, d, pair[0], pair[1], summary); err != nil {
+			fmt.Fprintf(stderr, "Error: %s\n", err)
+			return 2
+		}
+	}
+
+	return status
+}
+
+// sideOptions returns the options used to compile the templates of one side of the diff
+func sideOptions(options []mjml.ToHTMLOption, configPath string) ([]mjml.ToHTMLOption, error) {
+	if configPath == "" {
+		return options, nil
+	}
+
+	loaded, err := loadConfigFile(configPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(options, loaded), nil
+}
+
+// pairInputs pairs the files to compare. Directories are paired with directories, and their .mjml and .html files are
+// paired by name without extension, preferring .mjml files. Files only found on one side are returned separately.
+func pairInputs(before string, after string) ([][2]string, []string, error) {
+	beforeInfo, err := os.Stat(before)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	afterInfo, err := os.Stat(after)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !beforeInfo.IsDir() && !afterInfo.IsDir() {
+		return [][2]string{{before, after}}, nil, nil
+	}
+
+	if !beforeInfo.IsDir() || !afterInfo.IsDir() {
+		return nil, nil, fmt.Errorf("cannot compare a file with a directory")
+	}
+
+	beforeFiles, err := templateFiles(before)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	afterFiles, err := templateFiles(after)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		pairs [][2]string
+		only  []string
+	)
+
+	for _, name := range sortedNames(beforeFiles, afterFiles) {
+		b, inBefore := beforeFiles[name]
+		a, inAfter := afterFiles[name]
+
+		switch {
+		case !inAfter:
+			only = append(only, b)
+
+		case !inBefore:
+			only = append(only, a)
+
+		default:
+			pairs = append(pairs, [2]string{b, a})
+		}
+	}
+
+	return pairs, only, nil
+}
+
+// templateFiles returns the .mjml and .html files in a directory by name without extension
+func templateFiles(dir string) (map[string]string, error) {
+	files := map[string]string{}
+
+	for _, ext := range []string{".html", ".mjml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, "*"+ext))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range matches {
+			files[strings.TrimSuffix(filepath.Base(match), ext)] = match
+		}
+	}
+
+	return files, nil
+}
+
+func sortedNames(files ...map[string]string) []string {
+	var names []string
+
+	for _, f := range files {
+		for name := range f {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// compareFiles compares two templates. If both are mjml, the changes are attributed to the components generating them.
+func compareFiles(ctx context.Context, before string, beforeOptions []mjml.ToHTMLOption, after string, afterOptions []mjml.ToHTMLOption) (*diff.Diff, error) {
+	beforeInput, err := os.ReadFile(before)
+
+	if err != nil {
+		return nil, err
+	}
+
+	afterInput, err := os.ReadFile(after)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(before) == ".mjml" && filepath.Ext(after) == ".mjml" {
+		beforeOptions, err = includeOptions(before, beforeOptions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		afterOptions, err = includeOptions(after, afterOptions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return diff.CompareTemplates(ctx, string(beforeInput), beforeOptions, string(afterInput), afterOptions)
+	}
+
+	beforeHTML, err := compileHTML(ctx, before, string(beforeInput), beforeOptions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	afterHTML, err := compileHTML(ctx, after, string(afterInput), afterOptions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Compare(beforeHTML, afterHTML)
+}
+
+// compileHTML compiles an mjml file, or returns the content of an html file
+func compileHTML(ctx context.Context, file string, input string, options []mjml.ToHTMLOption) (string, error) {
+	if filepath.Ext(file) != ".mjml" {
+		return input, nil
+	}
+
+	options, err := includeOptions(file, options)
+
+	if err != nil {
+		return "", err
+	}
+
+	return mjml.ToHTML(ctx, input, options...)
+}
+
+// includeOptions adds the options resolving the mj-include tags of a file relative to it
+func includeOptions(file string, options []mjml.ToHTMLOption) ([]mjml.ToHTMLOption, error) {
+	root, name, err := fileSystem(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(options, []mjml.ToHTMLOption{mjml.WithIncludeFS(os.DirFS(root)), mjml.WithFilePath(name)}), nil
+}
+
+// writeDiff writes the changed components and style properties, followed by the unified diff unless summary is set
+func writeDiff(w io.Writer, d *diff.Diff, before string, after string, summary bool) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: %d changes\n", after, len(d.Changes))
+
+	for _, component := range d.Components() {
+		fmt.Fprintf(&sb, "  %s: %d\n", component.Name, component.Changes)
+	}
+
+	if properties := d.Properties(); len(properties) > 0 {
+		counts := make([]string, len(properties))
+
+		for i, property := range properties {
+			counts[i] = fmt.Sprintf("%s (%d)", property.Name, property.Changes)
+		}
+
+		fmt.Fprintf(&sb, "  style properties: %s\n", strings.Join(counts, ", "))
+	}
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return err
+	}
+
+	if summary {
+		return nil
+	}
+
+	return d.WriteUnified(w, before, after)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunDiff(t *testing.T) {
+
+	dir := t.TempDir()
+	before := filepath.Join(dir, "before")
+	after := filepath.Join(dir, "after")
+
+	changed := strings.Replace(testTemplate, "<mj-text>", `<mj-text color="#333333">`, 1)
+
+	files := map[string]string{
+		filepath.Join(before, "same.mjml"):    testTemplate,
+		filepath.Join(before, "changed.mjml"): testTemplate,
+		filepath.Join(before, "removed.mjml"): testTemplate,
+		filepath.Join(after, "same.mjml"):     testTemplate,
+		filepath.Join(after, "changed.mjml"):  changed,
+	}
+
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{"diff", before, after}, nil, &stdout, &stderr)
+
+	if status != 1 {
+		t.Fatalf("Expected exit status 1, got %d: %s", status, stderr.String())
+	}
+
+	output := stdout.String()
+
+	for _, expected := range []string{
+		"Only in " + filepath.Join(before, "removed.mjml") + "\n",
+		filepath.Join(after, "changed.mjml") + ": 1 changes\n  mj-section > mj-column > mj-text: 1\n  style properties: color (1)\n",
+		"--- " + filepath.Join(before, "changed.mjml"),
+		`<div style="color:#333333;`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+
+	if strings.Contains(output, "same.mjml") {
+		t.Errorf("Expected equal templates not to be reported, got:\n%s", output)
+	}
+
+	stdout.Reset()
+
+	status = run(context.Background(), []string{"diff", "--summary", filepath.Join(before, "changed.mjml"), filepath.Join(after, "changed.mjml")}, nil, &stdout, &stderr)
+
+	if status != 1 || strings.Contains(stdout.String(), "---") {
+		t.Errorf("Expected only the summary with exit status 1, got %d:\n%s", status, stdout.String())
+	}
+}
+
+func TestRunDiffConfigs(t *testing.T) {
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "template.mjml")
+	config := filepath.Join(dir, "beautify.mjmlconfig")
+
+	if err := os.WriteFile(input, []byte(testTemplate), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(config, []byte(`{"options": {"beautify": true}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{"diff", "--after.config", config, input}, nil, &stdout, &stderr)
+
+	if status != 0 || stdout.Len() != 0 {
+		t.Errorf("Expected beautified output to be equal with exit status 0, got %d: %s%s", status, stdout.String(), stderr.String())
+	}
+
+	status = run(context.Background(), []string{"diff", input}, nil, &stdout, &stderr)
+
+	if status != 2 {
+		t.Errorf("Expected exit status 2 comparing a single input without configurations, got %d", status)
+	}
+}
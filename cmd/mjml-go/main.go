@@ -0,0 +1,150 @@
+// Command mjml-go compiles MJML templates into HTML. Its flags mirror the MJML command line interface:
+//
+//	mjml-go [options] <files or globs...>
+//
+// By default, each input file is compiled to an HTML file with the same name in the current directory.
+//
+// The serve subcommand previews the templates in a directory in a browser, reloading them when they change:
+//
+//	mjml-go serve [options] [directory]
+//
+// The server subcommand serves the JSON protocol of js/src/server.js, so it can replace the Node.js server:
+//
+//	mjml-go server [options]
+//
+// The diff subcommand compares the html compiled from two versions of templates, or from the same templates using two
+// configurations, ignoring differences in whitespace and in the order of attributes and style properties:
+//
+//	mjml-go diff [options] <before> <after>
+//	mjml-go diff [options] --before.config <path> --after.config <path> <input>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+type cli struct {
+	stdin    bool
+	stdout   bool
+	output   string
+	validate bool
+	watch    bool
+	config   config
+
+	in  io.Reader
+	out io.Writer
+	err io.Writer
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "serve":
+			return serve(ctx, args[1:], stderr)
+
+		case "server":
+			return runServer(ctx, args[1:], stderr)
+
+		case "diff":
+			return runDiff(ctx, args[1:], stdout, stderr)
+		}
+	}
+
+	c := cli{
+		in:  stdin,
+		out: stdout,
+		err: stderr,
+	}
+
+	flags := flag.NewFlagSet("mjml-go", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: mjml-go [options] <files or globs...>")
+		fmt.Fprintln(stderr, "       mjml-go serve [options] [directory]")
+		fmt.Fprintln(stderr, "       mjml-go server [options]")
+		fmt.Fprintln(stderr, "       mjml-go diff [options] <before> <after>")
+		flags.PrintDefaults()
+	}
+
+	boolFlag(flags, &c.stdin, "Read MJML from stdin", "i", "stdin")
+	boolFlag(flags, &c.stdout, "Write the compiled HTML to stdout", "s", "stdout")
+	boolFlag(flags, &c.validate, "Validate the input without writing any output", "v", "validate")
+	stringFlag(flags, &c.output, "Output file or directory", "o", "output")
+	boolFlag(flags, &c.watch, "Recompile the input files when they or the files they include change", "w", "watch")
+
+	// -r is accepted for compatibility with the MJML CLI, where it is the default mode
+	var read bool
+	boolFlag(flags, &read, "Compile the input files (default)", "r", "read")
+
+	c.config.register(flags)
+
+	inputs, err := parseInterleaved(flags, args)
+
+	if err != nil {
+		return 2
+	}
+
+	options, err := c.config.toHTMLOptions()
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	if c.stdin {
+		return c.compileStdin(ctx, options)
+	}
+
+	if len(inputs) == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	if c.watch {
+		return c.watchFiles(ctx, inputs, options)
+	}
+
+	return c.compileFiles(ctx, inputs, options)
+}
+
+// parseInterleaved parses flags that appear before, between or after the positional arguments, which are returned
+func parseInterleaved(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+func boolFlag(flags *flag.FlagSet, p *bool, usage string, names ...string) {
+	for _, name := range names {
+		flags.BoolVar(p, name, false, usage)
+	}
+}
+
+func stringFlag(flags *flag.FlagSet, p *string, usage string, names ...string) {
+	for _, name := range names {
+		flags.StringVar(p, name, "", usage)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testTemplate = `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text>Hello World</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+func TestRunStdin(t *testing.T) {
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{"-i", "-s", "--config.minify", "true"}, strings.NewReader(testTemplate), &stdout, &stderr)
+
+	if status != 0 {
+		t.Fatalf("Expected exit status 0, got %d: %s", status, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "Hello World") || !strings.Contains(stdout.String(), "</div></body></html>") {
+		t.Errorf("Expected minified output containing the text, got: %s", stdout.String())
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+
+	dir := t.TempDir()
+
+	for _, name := range []string{"one.mjml", "two.mjml"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(testTemplate), 0644); err != nil {
+			t.Fatalf("Error writing template: %s", err)
+		}
+	}
+
+	outputDir := filepath.Join(dir, "out") + string(filepath.Separator)
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{filepath.Join(dir, "*.mjml"), "-o", outputDir, "--config.beautify=true"}, nil, &stdout, &stderr)
+
+	if status != 0 {
+		t.Fatalf("Expected exit status 0, got %d: %s", status, stderr.String())
+	}
+
+	for _, name := range []string{"one.html", "two.html"} {
+		output, err := os.ReadFile(filepath.Join(outputDir, name))
+
+		if err != nil {
+			t.Fatalf("Error reading output: %s", err)
+		}
+
+		if !strings.Contains(string(output), "Hello World") {
+			t.Errorf("Expected %s to contain the compiled template", name)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+
+	invalid := strings.Replace(testTemplate, "<mj-text>", `<mj-text color="not-a-color">`, 1)
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{"-i", "-v", "--config.validationLevel", "soft"}, strings.NewReader(invalid), &stdout, &stderr)
+
+	if status != 1 {
+		t.Errorf("Expected exit status 1, got %d", status)
+	}
+
+	if !strings.Contains(stderr.String(), "Line 5 of (mj-text)") {
+		t.Errorf("Expected error details to be printed, got: %s", stderr.String())
+	}
+
+	stderr.Reset()
+
+	status = run(context.Background(), []string{"-i", "--config.minifyOptions", `{"unknown": true}`}, strings.NewReader(testTemplate), &stdout, &stderr)
+
+	if status != 2 || !strings.Contains(stderr.String(), "unknown option unknown") {
+		t.Errorf("Expected exit status 2 for an unknown option, got %d: %s", status, stderr.String())
+	}
+}
+
+func TestRunWarnings(t *testing.T) {
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "invalid.mjml")
+	invalid := strings.Replace(testTemplate, "<mj-text>", `<mj-text color="not-a-color">`, 1)
+
+	if err := os.WriteFile(file, []byte(invalid), 0644); err != nil {
+		t.Fatalf("Error writing template: %s", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	status := run(context.Background(), []string{file, "-o", dir + string(filepath.Separator)}, nil, &stdout, &stderr)
+
+	if status != 0 {
+		t.Fatalf("Expected exit status 0 for validation errors at the soft level, got %d: %s", status, stderr.String())
+	}
+
+	if !strings.Contains(stderr.String(), "Line 5 of (mj-text)") {
+		t.Errorf("Expected validation errors to be printed as warnings, got: %s", stderr.String())
+	}
+
+	output, err := os.ReadFile(filepath.Join(dir, "invalid.html"))
+
+	if err != nil {
+		t.Fatalf("Error reading output: %s", err)
+	}
+
+	if !strings.Contains(string(output), "Hello World") {
+		t.Errorf("Expected the output compiled despite the validation errors, got: %s", output)
+	}
+
+	stderr.Reset()
+
+	status = run(context.Background(), []string{"-i", "-s", "--config.validationLevel", "strict"}, strings.NewReader(invalid), &stdout, &stderr)
+
+	if status != 1 {
+		t.Errorf("Expected exit status 1 for validation errors at the strict level, got %d: %s", status, stderr.String())
+	}
+
+	stderr.Reset()
+
+	missing := strings.Replace(testTemplate, "<mj-text>", `<mj-include path="./absent.mjml" /><mj-text>`, 1)
+
+	file = filepath.Join(dir, "missing.mjml")
+
+	if err := os.WriteFile(file, []byte(missing), 0644); err != nil {
+		t.Fatalf("Error writing template: %s", err)
+	}
+
+	status = run(context.Background(), []string{file, "-o", dir + string(filepath.Separator)}, nil, &stdout, &stderr)
+
+	if status != 1 || !strings.Contains(stderr.String(), "mj-include fails to read file") {
+		t.Errorf("Expected exit status 1 for include errors, got %d: %s", status, stderr.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "missing.html")); err == nil {
+		t.Error("Expected no output to be written for include errors")
+	}
+}
+
+func TestConfigOptions(t *testing.T) {
+
+	c := config{
+		beautifyOptions: `{"indent_size": 4, "wrap_attributes": "force", "templating": ["django"]}`,
+		juiceOptions:    `{"preserveMediaQueries": true, "extraCss": "p { color: red; }"}`,
+		minifyOptions:   `{"html5": true, "ignoreCustomFragments": ["<#.*#>"], "maxLineLength": 80}`,
+		fonts:           `{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"}`,
+		validationLevel: "strict",
+	}
+
+	options, err := c.toHTMLOptions()
+
+	if err != nil {
+		t.Fatalf("Error converting config to options: %s", err)
+	}
+
+	if len(options) != 5 {
+		t.Errorf("Expected 5 options, got %d", len(options))
+	}
+
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, ".mjmlconfig"), []byte(`{"options": {"minify": true, "keepComments": false}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	options, err = (&config{mjmlConfigPath: dir, beautify: "true"}).toHTMLOptions()
+
+	if err != nil {
+		t.Fatalf("Error loading .mjmlconfig: %s", err)
+	}
+
+	if len(options) != 3 {
+		t.Errorf("Expected 3 options, got %d", len(options))
+	}
+
+	for _, invalid := range []config{
+		{mjmlConfigPath: filepath.Join(dir, "missing")},
+		{minify: "yes please"},
+		{validationLevel: "lenient"},
+		{beautifyOptions: `{"indent_size": "four"}`},
+		{fonts: `["Raleway"]`},
+	} {
+		if _, err := invalid.toHTMLOptions(); err == nil {
+			t.Errorf("Expected an error for config %+v", invalid)
+		}
+	}
+}
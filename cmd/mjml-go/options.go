@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Boostport/mjml-go"
+)
+
+// config holds the --config.* flags of the MJML CLI
+type config struct {
+	mjmlConfigPath    string
+	beautify          string
+	beautifyOptions   string
+	fonts             string
+	juiceOptions      string
+	juicePreserveTags string
+	keepComments      string
+	minify            string
+	minifyOptions     string
+	validationLevel   string
+}
+
+func (c *config) register(flags *flag.FlagSet) {
+	flags.StringVar(&c.mjmlConfigPath, "config.mjmlConfigPath", "", "Path to a .mjmlconfig file, or a directory containing one, in JSON or YAML")
+	flags.StringVar(&c.beautify, "config.beautify", "", "Beautify the output (true or false)")
+	flags.StringVar(&c.beautifyOptions, "config.beautifyOptions", "", "js-beautify options as JSON")
+	flags.StringVar(&c.fonts, "config.fonts", "", "Default fonts as a JSON object mapping names to URLs")
+	flags.StringVar(&c.juiceOptions, "config.juiceOptions", "", "Juice options as JSON")
+	flags.StringVar(&c.juicePreserveTags, "config.juicePreserveTags", "", "Tags preserved by juice as JSON")
+	flags.StringVar(&c.keepComments, "config.keepComments", "", "Keep comments in the output (true or false)")
+	flags.StringVar(&c.minify, "config.minify", "", "Minify the output (true or false)")
+	flags.StringVar(&c.minifyOptions, "config.minifyOptions", "", "html-minifier options as JSON")
+	flags.StringVar(&c.validationLevel, "config.validationLevel", "", "Validation level (strict, soft or skip)")
+}
+
+func (c *config) toHTMLOptions() ([]mjml.ToHTMLOption, error) {
+	var options []mjml.ToHTMLOption
+
+	if c.mjmlConfigPath != "" {
+		loaded, err := loadConfigFile(c.mjmlConfigPath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, loaded...)
+	}
+
+	values := map[string]interface{}{}
+
+	bools := map[string]string{
+		"beautify":     c.beautify,
+		"keepComments": c.keepComments,
+		"minify":       c.minify,
+	}
+
+	for name, value := range bools {
+		if value == "" {
+			continue
+		}
+
+		b, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for --config.%s: %s", name, value)
+		}
+
+		values[name] = b
+	}
+
+	objects := map[string]string{
+		"beautifyOptions":   c.beautifyOptions,
+		"fonts":             c.fonts,
+		"juiceOptions":      c.juiceOptions,
+		"juicePreserveTags": c.juicePreserveTags,
+		"minifyOptions":     c.minifyOptions,
+	}
+
+	for name, value := range objects {
+		if value == "" {
+			continue
+		}
+
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("invalid value for --config.%s: invalid JSON", name)
+		}
+
+		values[name] = json.RawMessage(value)
+	}
+
+	if c.validationLevel != "" {
+		values["validationLevel"] = c.validationLevel
+	}
+
+	encoded, err := json.Marshal(map[string]interface{}{"options": values})
+
+	if err != nil {
+		return nil, err
+	}
+
+	flagOptions, err := mjml.LoadOptions(bytes.NewReader(encoded))
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid --config flags: %w", err)
+	}
+
+	return append(options, flagOptions...), nil
+}
+
+// loadConfigFile loads the options of a .mjmlconfig file. If path is a directory, the .mjmlconfig file in it is loaded.
+func loadConfigFile(path string) ([]mjml.ToHTMLOption, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, ".mjmlconfig")
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening config: %w", err)
+	}
+
+	defer f.Close()
+
+	options, err := mjml.LoadOptions(f)
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading %s: %w", path, err)
+	}
+
+	return options, nil
+}
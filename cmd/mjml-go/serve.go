@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/Boostport/mjml-go/preview"
+)
+
+// serve runs the serve subcommand, which previews the templates in a directory with live reload until ctx is done
+func serve(ctx context.Context, args []string, stderr io.Writer) int {
+	var (
+		port int
+		host string
+		c    config
+	)
+
+	flags := flag.NewFlagSet("mjml-go serve", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: mjml-go serve [options] [directory]")
+		flags.PrintDefaults()
+	}
+
+	for _, name := range []string{"p", "port"} {
+		flags.IntVar(&port, name, 8080, "Port to listen on")
+	}
+
+	flags.StringVar(&host, "host", "localhost", "Host to listen on")
+	c.register(flags)
+
+	inputs, err := parseInterleaved(flags, args)
+
+	if err != nil {
+		return 2
+	}
+
+	if len(inputs) > 1 {
+		flags.Usage()
+		return 2
+	}
+
+	dir := "."
+
+	if len(inputs) == 1 {
+		dir = inputs[0]
+	}
+
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		fmt.Fprintf(stderr, "Error: %s is not a directory\n", dir)
+		return 2
+	}
+
+	options, err := c.toHTMLOptions()
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 2
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	server := &http.Server{Handler: preview.New(os.DirFS(dir), options...)}
+
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	fmt.Fprintf(stderr, "Serving templates in %s at http://%s/\n", dir, listener.Addr())
+
+	if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServe(t *testing.T) {
+
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "welcome.mjml"), []byte(testTemplate), 0644); err != nil {
+		t.Fatalf("Error writing template: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var stderr syncBuffer
+	status := make(chan int)
+
+	go func() {
+		status <- run(ctx, []string{"serve", "-p", "0", dir, "--config.minify", "true"}, nil, io.Discard, &stderr)
+	}()
+
+	address := regexp.MustCompile(`at (http://\S+/)`)
+	deadline := time.Now().Add(10 * time.Second)
+
+	for !address.MatchString(stderr.String()) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for the server to start: %s", stderr.String())
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	resp, err := http.Get(address.FindStringSubmatch(stderr.String())[1] + "welcome")
+
+	if err != nil {
+		t.Fatalf("Error requesting template: %s", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		t.Fatalf("Error reading response: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "Hello World") || !strings.Contains(string(body), "EventSource") {
+		t.Errorf("Expected the template with the live reload script, got %d: %s", resp.StatusCode, body)
+	}
+
+	cancel()
+
+	if s := <-status; s != 0 {
+		t.Errorf("Expected exit status 0, got %d: %s", s, stderr.String())
+	}
+}
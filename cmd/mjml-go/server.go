@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Boostport/mjml-go/server"
+)
+
+// shutdownTimeout is how long in-flight compilations are given to finish when the server is stopped
+const shutdownTimeout = 10 * time.Second
+
+// runServer runs the server subcommand, which serves the JSON protocol of js/src/server.js until ctx is done
+func runServer(ctx context.Context, args []string, stderr io.Writer) int {
+	var (
+		port           int
+		host           string
+		maxRequestSize int64
+		timeout        time.Duration
+	)
+
+	flags := flag.NewFlagSet("mjml-go server", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: mjml-go server [options]")
+		flags.PrintDefaults()
+	}
+
+	for _, name := range []string{"p", "port"} {
+		flags.IntVar(&port, name, 8888, "Port to listen on")
+	}
+
+	flags.StringVar(&host, "host", "", "Host to listen on (default all interfaces)")
+	flags.Int64Var(&maxRequestSize, "max-request-size", server.DefaultMaxRequestSize, "Maximum size of request bodies in bytes")
+	flags.DurationVar(&timeout, "timeout", server.DefaultTimeout, "Maximum duration requests wait for their compilation")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return 2
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	srv := &http.Server{
+		Handler: server.New(server.WithMaxRequestSize(maxRequestSize), server.WithTimeout(timeout)),
+	}
+
+	shutdown := make(chan struct{})
+
+	go func() {
+		defer close(shutdown)
+
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(stderr, "Listening on http://%s/\n", listener.Addr())
+
+	if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	<-shutdown
+
+	return 0
+}
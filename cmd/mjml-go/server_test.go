@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServer(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var stderr syncBuffer
+	status := make(chan int)
+
+	go func() {
+		status <- run(ctx, []string{"server", "-p", "0", "--host", "localhost", "--timeout", "10s"}, nil, io.Discard, &stderr)
+	}()
+
+	address := regexp.MustCompile(`on (http://\S+/)`)
+	deadline := time.Now().Add(10 * time.Second)
+
+	for !address.MatchString(stderr.String()) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for the server to start: %s", stderr.String())
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	input, err := json.Marshal(map[string]interface{}{
+		"mjml":    testTemplate,
+		"options": map[string]interface{}{"minify": true},
+	})
+
+	if err != nil {
+		t.Fatalf("Error encoding request: %s", err)
+	}
+
+	resp, err := http.Post(address.FindStringSubmatch(stderr.String())[1], "application/json", strings.NewReader(string(input)))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	var result struct {
+		HTML string `json:"html"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	resp.Body.Close()
+
+	if err != nil {
+		t.Fatalf("Error decoding response: %s", err)
+	}
+
+	if !strings.Contains(result.HTML, "Hello World") {
+		t.Errorf("Expected the compiled template, got: %s", result.HTML)
+	}
+
+	cancel()
+
+	if s := <-status; s != 0 {
+		t.Errorf("Expected exit status 0, got %d: %s", s, stderr.String())
+	}
+}
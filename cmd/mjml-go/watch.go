@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+var (
+	// watchInterval is how often watched files are checked for changes
+	watchInterval = 200 * time.Millisecond
+
+	// watchDebounce is how long files must be unchanged before the affected templates are recompiled
+	watchDebounce = 300 * time.Millisecond
+)
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+type watcher struct {
+	c         *cli
+	inputs    []string
+	outputDir string
+	options   []mjml.ToHTMLOption
+
+	// dependencies maps each template to the files it includes, directly or transitively
+	dependencies map[string][]string
+	states       map[string]fileState
+}
+
+// watchFiles compiles the input files and recompiles them whenever they or the files they include change, until ctx is
+// done. Compilation errors are printed without stopping the watcher.
+func (c *cli) watchFiles(ctx context.Context, inputs []string, options []mjml.ToHTMLOption) int {
+	files, err := expandInputs(inputs)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error: %s\n", err)
+		return 1
+	}
+
+	outputDir, err := c.outputDir(files)
+
+	if err != nil {
+		fmt.Fprintf(c.err, "Error: %s\n", err)
+		return 1
+	}
+
+	w := watcher{
+		c:            c,
+		inputs:       inputs,
+		outputDir:    outputDir,
+		options:      options,
+		dependencies: map[string][]string{},
+		states:       map[string]fileState{},
+	}
+
+	for _, file := range files {
+		w.compile(ctx, file)
+	}
+
+	w.scan()
+
+	fmt.Fprintf(c.err, "Watching %d templates for changes\n", len(w.dependencies))
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	pending := map[string]bool{}
+	var lastChange time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0
+
+		case now := <-ticker.C:
+			changed := w.scan()
+
+			for _, file := range changed {
+				pending[file] = true
+				lastChange = now
+			}
+
+			if len(pending) == 0 || now.Sub(lastChange) < watchDebounce {
+				continue
+			}
+
+			for _, file := range w.affected(pending) {
+				w.compile(ctx, file)
+			}
+
+			clear(pending)
+		}
+	}
+}
+
+// compile compiles a template and updates the files it depends on
+func (w *watcher) compile(ctx context.Context, file string) {
+	if err := w.c.compileFile(ctx, file, w.outputDir, w.options); err != nil {
+		fmt.Fprintf(w.c.err, "Error compiling %s: %s\n", file, err)
+	} else {
+		fmt.Fprintf(w.c.err, "Compiled %s\n", file)
+	}
+
+	root, name, err := fileSystem(file)
+
+	if err != nil {
+		return
+	}
+
+	included, err := mjml.IncludedFiles(os.DirFS(root), name)
+
+	dependencies := make([]string, 0, len(included))
+
+	for _, dependency := range included {
+		dependencies = append(dependencies, filepath.Join(root, filepath.FromSlash(dependency)))
+	}
+
+	// If the includes cannot be resolved, watch the files that were found, including a missing partial, along with the
+	// previous dependencies, so creating or fixing a partial recompiles the templates that include it
+	if err != nil {
+		for _, dependency := range w.dependencies[file] {
+			if !slices.Contains(dependencies, dependency) {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+	}
+
+	w.dependencies[file] = dependencies
+}
+
+// scan returns the watched files that were created, modified or removed since the previous scan. Templates matching
+// the inputs that were created since the previous scan are watched as well.
+func (w *watcher) scan() []string {
+	if files, err := expandInputs(w.inputs); err == nil {
+		for _, file := range files {
+			if _, ok := w.dependencies[file]; !ok {
+				w.dependencies[file] = nil
+			}
+		}
+	}
+
+	watched := map[string]bool{}
+
+	for file, dependencies := range w.dependencies {
+		watched[file] = true
+
+		for _, dependency := range dependencies {
+			watched[dependency] = true
+		}
+	}
+
+	var changed []string
+
+	for file := range watched {
+		var state fileState
+
+		if info, err := os.Stat(file); err == nil {
+			state = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+
+		if previous, ok := w.states[file]; !ok || previous != state {
+			changed = append(changed, file)
+		}
+
+		w.states[file] = state
+	}
+
+	for file := range w.states {
+		if !watched[file] {
+			delete(w.states, file)
+		}
+	}
+
+	return changed
+}
+
+// affected returns the templates that are changed or include a changed file, skipping templates that were removed
+func (w *watcher) affected(changed map[string]bool) []string {
+	var files []string
+
+	for file, dependencies := range w.dependencies {
+		if _, err := os.Stat(file); err != nil {
+			delete(w.dependencies, file)
+			continue
+		}
+
+		if changed[file] || slices.ContainsFunc(dependencies, func(dependency string) bool { return changed[dependency] }) {
+			files = append(files, file)
+		}
+	}
+
+	slices.Sort(files)
+
+	return files
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// watchTest writes the files of a watch test to a temporary directory
+type watchTest struct {
+	t   *testing.T
+	dir string
+}
+
+func newWatchTest(t *testing.T) watchTest {
+	t.Helper()
+
+	watchInterval = 10 * time.Millisecond
+	watchDebounce = 30 * time.Millisecond
+
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, "partials"), 0755); err != nil {
+		t.Fatalf("Error creating partials directory: %s", err)
+	}
+
+	return watchTest{t: t, dir: dir}
+}
+
+func (w watchTest) write(name string, contents string) {
+	w.t.Helper()
+
+	if err := os.WriteFile(filepath.Join(w.dir, name), []byte(contents), 0644); err != nil {
+		w.t.Fatalf("Error writing %s: %s", name, err)
+	}
+}
+
+// watch runs the watcher on the templates of the directory until the returned function is called, which returns the
+// exit status
+func (w watchTest) watch(stderr *syncBuffer) func() int {
+	ctx, cancel := context.WithCancel(context.Background())
+	status := make(chan int)
+
+	go func() {
+		status <- run(ctx, []string{"-w", filepath.Join(w.dir, "*.mjml"), "-o", filepath.Join(w.dir, "out") + string(filepath.Separator)}, nil, &bytes.Buffer{}, stderr)
+	}()
+
+	return sync.OnceValue(func() int {
+		cancel()
+		return <-status
+	})
+}
+
+func (w watchTest) waitFor(description string, condition func() bool) {
+	w.t.Helper()
+
+	deadline := time.Now().Add(30 * time.Second)
+
+	for !condition() {
+		if time.Now().After(deadline) {
+			w.t.Fatalf("Timed out waiting for %s", description)
+		}
+
+		time.Sleep(watchInterval)
+	}
+}
+
+func (w watchTest) outputContains(text string) func() bool {
+	return func() bool {
+		output, err := os.ReadFile(filepath.Join(w.dir, "out", "welcome.html"))
+		return err == nil && strings.Contains(string(output), text)
+	}
+}
+
+const watchTemplate = `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-include path="./partials/greeting.mjml" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+func TestWatch(t *testing.T) {
+
+	w := newWatchTest(t)
+
+	w.write("welcome.mjml", watchTemplate)
+	w.write("partials/greeting.mjml", `<mj-text>Version one</mj-text>`)
+
+	var stderr syncBuffer
+	stop := w.watch(&stderr)
+	defer stop()
+
+	w.waitFor("the initial compilation", w.outputContains("Version one"))
+	w.waitFor("the watcher to start", func() bool { return strings.Contains(stderr.String(), "Watching 1 templates") })
+
+	w.write("partials/greeting.mjml", `<mj-text>Version two after a change to the partial</mj-text>`)
+
+	w.waitFor("the partial change to be compiled", w.outputContains("Version two"))
+
+	w.write("partials/greeting.mjml", `<mj-text color="not-a-color">Broken</mj-text`)
+
+	w.waitFor("the compilation error", func() bool { return strings.Contains(stderr.String(), "Error compiling") })
+
+	w.write("partials/greeting.mjml", `<mj-text>Version three after fixing the partial</mj-text>`)
+
+	w.waitFor("the fixed partial to be compiled", w.outputContains("Version three"))
+
+	if s := stop(); s != 0 {
+		t.Errorf("Expected exit status 0, got %d: %s", s, stderr.String())
+	}
+}
+
+func TestWatchMissingPartial(t *testing.T) {
+
+	w := newWatchTest(t)
+
+	w.write("welcome.mjml", watchTemplate)
+
+	var stderr syncBuffer
+	stop := w.watch(&stderr)
+	defer stop()
+
+	w.waitFor("the include error", func() bool { return strings.Contains(stderr.String(), "Error compiling") })
+	w.waitFor("the watcher to start", func() bool { return strings.Contains(stderr.String(), "Watching 1 templates") })
+
+	w.write("partials/greeting.mjml", `<mj-text>Created after the watcher started</mj-text>`)
+
+	w.waitFor("the created partial to be compiled", w.outputContains("Created after the watcher started"))
+
+	if s := stop(); s != 0 {
+		t.Errorf("Expected exit status 0, got %d: %s", s, stderr.String())
+	}
+}
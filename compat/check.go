@@ -0,0 +1,343 @@
+package compat
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+	"github.com/Boostport/mjml-go/ast"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Issue is a feature used by the html that is not fully supported by some of the checked clients
+type Issue struct {
+	Feature Feature
+
+	// Unsupported are the clients not supporting the feature, and Partial the clients supporting it partially
+	Unsupported []Client
+	Partial     []Client
+
+	// Occurrences is the number of times the feature is used in the html
+	Occurrences int
+
+	// Lines are the lines of the mjml source using the feature. It is empty if the feature is only used by the html
+	// generated for components, such as the media queries making columns responsive.
+	Lines []int
+}
+
+// Report lists the issues found by Check
+type Report struct {
+	Issues []Issue
+}
+
+// Check reports the features used by the html of a compiled template that are not fully supported by clients, or all
+// the clients in the dataset if none are passed. Issues include the lines of the mjml source using the feature where
+// they can be found.
+func Check(result *mjml.Result, clients ...Client) (*Report, error) {
+	root, err := result.MJML()
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := CheckHTML(result.HTML, clients...)
+
+	for i, issue := range report.Issues {
+		report.Issues[i].Lines = sourceLines(root, issue.Feature)
+	}
+
+	return report, nil
+}
+
+// CheckHTML reports the features used by html that are not fully supported by clients, or all the clients in the
+// dataset if none are passed. Content in conditional comments for Outlook is only checked for Outlook for Windows,
+// and content hidden from Outlook using conditional comments is not checked for it.
+func CheckHTML(document string, clients ...Client) *Report {
+	if len(clients) == 0 {
+		clients = Clients()
+	}
+
+	a := analyzer{uses: map[string]*use{}}
+	a.html(document, audienceAll)
+
+	report := &Report{}
+
+	for _, feature := range dataset.Features {
+		u, ok := a.uses[feature.ID]
+
+		if !ok {
+			continue
+		}
+
+		issue := Issue{Feature: feature.clone(), Occurrences: u.occurrences}
+
+		for _, client := range clients {
+			if !u.audiences[audienceOf(client)] && !u.audiences[audienceAll] {
+				continue
+			}
+
+			switch feature.Support[client] {
+			case Unsupported:
+				issue.Unsupported = append(issue.Unsupported, client)
+
+			case Partial:
+				issue.Partial = append(issue.Partial, client)
+			}
+		}
+
+		if len(issue.Unsupported) > 0 || len(issue.Partial) > 0 {
+			report.Issues = append(report.Issues, issue)
+		}
+	}
+
+	return report
+}
+
+// audience is the clients seeing a part of the html, depending on the conditional comments it is in
+type audience int
+
+const (
+	audienceAll audience = iota
+	audienceOutlook
+	audienceNotOutlook
+)
+
+// audienceOf returns the audience of the parts of html only seen by a client
+func audienceOf(client Client) audience {
+	if client == OutlookWindows {
+		return audienceOutlook
+	}
+
+	return audienceNotOutlook
+}
+
+type use struct {
+	occurrences int
+	audiences   map[audience]bool
+}
+
+type analyzer struct {
+	uses map[string]*use
+}
+
+var (
+	conditionalComment = regexp.MustCompile(`(?s)^\[if\s+([^\]]*)\]>(.*)<!\[endif\]$`)
+	cssComment         = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	cssAtRule          = regexp.MustCompile(`@([-a-zA-Z]+)([^{;]*)`)
+	cssSelector        = regexp.MustCompile(`(?:^|[{}])\s*([^{}@;]+)\{`)
+	cssPseudoClass     = regexp.MustCompile(`::?([-a-zA-Z]+)`)
+	cssMediaFeature    = regexp.MustCompile(`\(\s*([-a-zA-Z]+)`)
+	cssDeclaration     = regexp.MustCompile(`(?:^|[{;])\s*([-a-zA-Z]+)\s*:\s*([^;{}]+)(?:[;}]|$)`)
+	cssFunction        = regexp.MustCompile(`([-a-zA-Z]+)\(`)
+)
+
+// html finds the features used by html seen by audience
+func (a *analyzer) html(document string, aud audience) {
+	z := html.NewTokenizer(strings.NewReader(document))
+	current := aud
+
+	for {
+		tt := z.Next()
+
+		switch tt {
+		case html.ErrorToken:
+			return
+
+		case html.CommentToken:
+			current = a.comment(string(z.Text()), aud, current)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+
+			a.match(current, func(m Match) bool { return slices.Contains(m.Elements, token.Data) })
+
+			for _, attr := range token.Attr {
+				a.match(current, func(m Match) bool { return slices.Contains(m.Attributes, attr.Key) })
+
+				if attr.Key == "style" {
+					a.declarations(attr.Val, current)
+				}
+			}
+
+			if token.DataAtom == atom.Style && tt == html.StartTagToken && z.Next() == html.TextToken {
+				a.css(string(z.Text()), current)
+			}
+		}
+	}
+}
+
+// comment checks the content of conditional comments, returning the audience of the html following the comment
+func (a *analyzer) comment(text string, aud audience, current audience) audience {
+	// <!--[if !mso]><!--> starts html hidden from Outlook, which ends at <!--<![endif]-->
+	if strings.HasPrefix(text, "[if !mso") && strings.HasSuffix(text, "<!") {
+		return audienceNotOutlook
+	}
+
+	if text == "<![endif]" {
+		return aud
+	}
+
+	match := conditionalComment.FindStringSubmatch(text)
+
+	if match == nil || !strings.Contains(match[1], "mso") || strings.HasPrefix(match[1], "!") {
+		return current
+	}
+
+	a.html(match[2], audienceOutlook)
+
+	return current
+}
+
+// css finds the features used by a style sheet
+func (a *analyzer) css(css string, aud audience) {
+	css = cssComment.ReplaceAllString(css, "")
+
+	for _, match := range cssAtRule.FindAllStringSubmatch(css, -1) {
+		name := strings.ToLower(match[1])
+
+		a.match(aud, func(m Match) bool { return slices.Contains(m.AtRules, name) })
+
+		if name != "media" {
+			continue
+		}
+
+		for _, feature := range cssMediaFeature.FindAllStringSubmatch(match[2], -1) {
+			a.match(aud, func(m Match) bool { return slices.Contains(m.MediaFeatures, strings.ToLower(feature[1])) })
+		}
+	}
+
+	for _, selector := range cssSelector.FindAllStringSubmatch(css, -1) {
+		for _, pseudoClass := range cssPseudoClass.FindAllStringSubmatch(selector[1], -1) {
+			a.match(aud, func(m Match) bool { return slices.Contains(m.PseudoClasses, strings.ToLower(pseudoClass[1])) })
+		}
+	}
+
+	a.declarations(css, aud)
+}
+
+// declarations finds the features used by the CSS declarations of a style attribute or style sheet
+func (a *analyzer) declarations(css string, aud audience) {
+	for _, declaration := range cssDeclaration.FindAllStringSubmatch(css, -1) {
+		property := strings.ToLower(declaration[1])
+		value := strings.ToLower(declaration[2])
+
+		a.match(aud, func(m Match) bool {
+			if !slices.Contains(m.Properties, property) {
+				return false
+			}
+
+			return len(m.Values) == 0 || slices.ContainsFunc(m.Values, func(v string) bool { return strings.Contains(value, v) })
+		})
+
+		for _, function := range cssFunction.FindAllStringSubmatch(value, -1) {
+			a.match(aud, func(m Match) bool { return slices.Contains(m.Functions, function[1]) })
+		}
+	}
+}
+
+// match records the use of the features matched by fn
+func (a *analyzer) match(aud audience, fn func(m Match) bool) {
+	for _, feature := range dataset.Features {
+		if !fn(feature.Match) {
+			continue
+		}
+
+		u, ok := a.uses[feature.ID]
+
+		if !ok {
+			u = &use{audiences: map[audience]bool{}}
+			a.uses[feature.ID] = u
+		}
+
+		u.occurrences++
+		u.audiences[aud] = true
+	}
+}
+
+// sourceLines returns the lines of the mjml nodes using a feature, either through an attribute output as the feature
+// or in their content or attribute values
+func sourceLines(root *ast.Node, feature Feature) []int {
+	pattern := sourcePattern(feature.Match)
+
+	var lines []int
+
+	root.Walk(func(n *ast.Node) bool {
+		if n.Type != ast.ElementNode || n.Line == 0 {
+			return true
+		}
+
+		used := pattern != nil && pattern.MatchString(n.Content)
+
+		for _, attr := range n.Attributes {
+			used = used || slices.Contains(feature.MJMLAttributes, attr.Name) || (pattern != nil && pattern.MatchString(attr.Value))
+		}
+
+		if used && !slices.Contains(lines, n.Line) {
+			lines = append(lines, n.Line)
+		}
+
+		return true
+	})
+
+	slices.Sort(lines)
+
+	return lines
+}
+
+// sourcePattern returns a regular expression finding a feature in mjml content, such as the css of mj-style or the
+// html of mj-raw
+func sourcePattern(m Match) *regexp.Regexp {
+	var alternatives []string
+
+	quote := func(names []string) string {
+		quoted := make([]string, 0, len(names))
+
+		for _, name := range names {
+			quoted = append(quoted, regexp.QuoteMeta(name))
+		}
+
+		return strings.Join(quoted, "|")
+	}
+
+	if len(m.Properties) > 0 {
+		property := `(?:^|[^-\w])(?:` + quote(m.Properties) + `)\s*:`
+
+		if len(m.Values) > 0 {
+			property += `[^;{}"']*(?:` + quote(m.Values) + `)`
+		}
+
+		alternatives = append(alternatives, property)
+	}
+
+	if len(m.AtRules) > 0 {
+		alternatives = append(alternatives, `@(?:`+quote(m.AtRules)+`)\b`)
+	}
+
+	if len(m.MediaFeatures) > 0 {
+		alternatives = append(alternatives, `\(\s*(?:`+quote(m.MediaFeatures)+`)\b`)
+	}
+
+	if len(m.PseudoClasses) > 0 {
+		alternatives = append(alternatives, `:(?:`+quote(m.PseudoClasses)+`)\b`)
+	}
+
+	if len(m.Functions) > 0 {
+		alternatives = append(alternatives, `(?:^|[^-\w])(?:`+quote(m.Functions)+`)\(`)
+	}
+
+	if len(m.Elements) > 0 {
+		alternatives = append(alternatives, `<(?:`+quote(m.Elements)+`)\b`)
+	}
+
+	if len(m.Attributes) > 0 {
+		alternatives = append(alternatives, `\s(?:`+quote(m.Attributes)+`)\s*=`)
+	}
+
+	if len(alternatives) == 0 {
+		return nil
+	}
+
+	return regexp.MustCompile(`(?i)` + strings.Join(alternatives, "|"))
+}
@@ -0,0 +1,164 @@
+// Package compat reports the HTML and CSS features used by compiled templates that are not supported by email
+// clients, such as Outlook for Windows or the Gmail apps, before the emails are sent. Support is looked up in an
+// offline dataset embedded in the package, in the spirit of caniemail.com, so no network access is needed.
+//
+//	result, err := mjml.Compile(ctx, input)
+//	report, err := compat.Check(result, compat.OutlookWindows, compat.GmailAndroid)
+//
+//	for _, issue := range report.Issues {
+//		fmt.Printf("%s is not supported by %v (lines %v)\n", issue.Feature.Title, issue.Unsupported, issue.Lines)
+//	}
+package compat
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Client is an email client in the dataset
+type Client string
+
+const (
+	OutlookWindows Client = "outlook-windows"
+	OutlookMacOS   Client = "outlook-macos"
+	OutlookCom     Client = "outlook-com"
+	GmailWeb       Client = "gmail-web"
+	GmailAndroid   Client = "gmail-android"
+	GmailIOS       Client = "gmail-ios"
+	AppleMailMacOS Client = "apple-mail-macos"
+	AppleMailIOS   Client = "apple-mail-ios"
+	YahooMail      Client = "yahoo-mail"
+	SamsungEmail   Client = "samsung-email"
+)
+
+// Name returns the name of the client, such as Outlook for Windows
+func (c Client) Name() string {
+	if name, ok := dataset.clientNames[c]; ok {
+		return name
+	}
+
+	return string(c)
+}
+
+// Support is the level of support of a feature by a client
+type Support string
+
+const (
+	Supported   Support = "y"
+	Partial     Support = "a"
+	Unsupported Support = "n"
+)
+
+// Feature is an HTML or CSS feature in the dataset
+type Feature struct {
+	// ID identifies the feature, such as css-border-radius
+	ID string `json:"id"`
+
+	// Title is the name of the feature, such as border-radius
+	Title string `json:"title"`
+
+	// Support is the support of the feature by each client in the dataset
+	Support map[Client]Support `json:"support"`
+
+	// Notes explain partial or missing support by clients
+	Notes map[Client]string `json:"notes,omitempty"`
+
+	Match Match `json:"match"`
+
+	// MJMLAttributes are the attributes of mjml components which are output as the feature
+	MJMLAttributes []string `json:"mjmlAttributes,omitempty"`
+}
+
+// clone returns a copy of the feature that does not share maps or slices with f, so that the dataset cannot be
+// modified through the features returned to callers
+func (f Feature) clone() Feature {
+	f.Support = maps.Clone(f.Support)
+	f.Notes = maps.Clone(f.Notes)
+	f.MJMLAttributes = slices.Clone(f.MJMLAttributes)
+	f.Match = Match{
+		Properties:    slices.Clone(f.Match.Properties),
+		Values:        slices.Clone(f.Match.Values),
+		AtRules:       slices.Clone(f.Match.AtRules),
+		MediaFeatures: slices.Clone(f.Match.MediaFeatures),
+		PseudoClasses: slices.Clone(f.Match.PseudoClasses),
+		Functions:     slices.Clone(f.Match.Functions),
+		Elements:      slices.Clone(f.Match.Elements),
+		Attributes:    slices.Clone(f.Match.Attributes),
+	}
+
+	return f
+}
+
+// Match describes how a feature is detected in html. A feature is used if any of the fields match.
+type Match struct {
+	// Properties are CSS properties. If Values is set, the value of the property must contain one of the values.
+	Properties []string `json:"properties,omitempty"`
+	Values     []string `json:"values,omitempty"`
+
+	// AtRules are CSS at-rules without the @, such as media
+	AtRules []string `json:"atRules,omitempty"`
+
+	// MediaFeatures are features of @media rules, such as prefers-color-scheme
+	MediaFeatures []string `json:"mediaFeatures,omitempty"`
+
+	// PseudoClasses are CSS pseudo-classes without the colon, such as hover
+	PseudoClasses []string `json:"pseudoClasses,omitempty"`
+
+	// Functions are CSS functions, such as linear-gradient
+	Functions []string `json:"functions,omitempty"`
+
+	// Elements and Attributes are HTML element and attribute names
+	Elements   []string `json:"elements,omitempty"`
+	Attributes []string `json:"attributes,omitempty"`
+}
+
+//go:embed data/features.json
+var data []byte
+
+var dataset struct {
+	Clients []struct {
+		ID   Client `json:"id"`
+		Name string `json:"name"`
+	} `json:"clients"`
+
+	Features []Feature `json:"features"`
+
+	clientNames map[Client]string
+}
+
+func init() {
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		panic(fmt.Sprintf("Error decoding compatibility dataset: %s", err))
+	}
+
+	dataset.clientNames = map[Client]string{}
+
+	for _, client := range dataset.Clients {
+		dataset.clientNames[client.ID] = client.Name
+	}
+}
+
+// Clients returns the clients in the dataset
+func Clients() []Client {
+	clients := make([]Client, 0, len(dataset.Clients))
+
+	for _, client := range dataset.Clients {
+		clients = append(clients, client.ID)
+	}
+
+	return clients
+}
+
+// Features returns the features in the dataset
+func Features() []Feature {
+	features := make([]Feature, 0, len(dataset.Features))
+
+	for _, feature := range dataset.Features {
+		features = append(features, feature.clone())
+	}
+
+	return features
+}
@@ -0,0 +1,148 @@
+package compat
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Boostport/mjml-go"
+)
+
+func TestCheckHTML(t *testing.T) {
+
+	html := `<html><head>
+<style>@media (prefers-color-scheme: dark) { .a { color: #fff; } } .b:hover { color: red; } /* .d { position: absolute; } */</style>
+<!--[if mso]><style>.c { border-radius: 3px; }</style><![endif]-->
+</head><body>
+<!--[if !mso]><!--><div style="display:grid;background:url(x.png)"></div><!--<![endif]-->
+<!--[if mso | IE]><table background="x.png"><tr><td><![endif]-->
+<img srcset="a.png 2x"><img srcset="b.png 2x">
+<!--[if mso | IE]></td></tr></table><![endif]-->
+</body></html>`
+
+	report := CheckHTML(html, OutlookWindows, GmailWeb, AppleMailIOS)
+
+	expected := map[string][]Client{
+		"css-border-radius":        {OutlookWindows},
+		"css-display-grid":         {GmailWeb},
+		"css-at-media":             {OutlookWindows},
+		"css-prefers-color-scheme": {OutlookWindows, GmailWeb},
+		"css-pseudo-class-hover":   {OutlookWindows},
+		"html-srcset":              {OutlookWindows, GmailWeb},
+		"html-background":          {OutlookWindows},
+	}
+
+	issues := map[string][]Client{}
+
+	for _, issue := range report.Issues {
+		issues[issue.Feature.ID] = issue.Unsupported
+
+		if len(issue.Partial) > 0 {
+			t.Errorf("Expected no partial support for %s, got: %v", issue.Feature.ID, issue.Partial)
+		}
+	}
+
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("Expected issues %v, got %v", expected, issues)
+	}
+
+	if report.Issues[len(report.Issues)-2].Occurrences != 2 {
+		t.Errorf("Expected srcset to be used twice, got: %d", report.Issues[len(report.Issues)-2].Occurrences)
+	}
+}
+
+func TestCheck(t *testing.T) {
+
+	input := `<mjml>
+  <mj-head>
+    <mj-style>
+      .cta:hover { opacity: 0.8; }
+      .row { display: flex; }
+    </mj-style>
+  </mj-head>
+  <mj-body>
+    <mj-section background-url="https://example.com/bg.png">
+      <mj-column>
+        <mj-button css-class="cta" href="https://example.com">Buy</mj-button>
+        <mj-raw><video src="https://example.com/a.mp4"></video></mj-raw>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	result, err := mjml.Compile(context.Background(), input, mjml.WithTitle("Hello"))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	report, err := Check(result, OutlookWindows, GmailAndroid)
+
+	if err != nil {
+		t.Fatalf("Error checking template: %s", err)
+	}
+
+	expected := map[string][]int{
+		"css-background-image":   {9},
+		"css-border-radius":      nil,
+		"css-display-flex":       {3},
+		"css-opacity":            {3},
+		"css-at-media":           nil,
+		"css-at-import":          nil,
+		"css-pseudo-class-hover": {3},
+		"html-video":             {12},
+		"html-background":        {9},
+	}
+
+	lines := map[string][]int{}
+
+	for _, issue := range report.Issues {
+		lines[issue.Feature.ID] = issue.Lines
+	}
+
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected lines %v, got %v", expected, lines)
+	}
+
+	if len(Clients()) != 10 || OutlookWindows.Name() != "Outlook for Windows" {
+		t.Errorf("Unexpected clients: %v", Clients())
+	}
+}
+
+func TestFeaturesCopy(t *testing.T) {
+
+	result, err := mjml.Compile(context.Background(), `<mjml><mj-body><mj-section><mj-column><mj-button href="https://example.com">Buy</mj-button></mj-column></mj-section></mj-body></mjml>`)
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	report, err := Check(result, OutlookWindows)
+
+	if err != nil {
+		t.Fatalf("Error checking template: %s", err)
+	}
+
+	for _, feature := range Features() {
+		feature.Support[OutlookWindows] = Supported
+		clear(feature.Notes)
+
+		if len(feature.Match.Properties) > 0 {
+			feature.Match.Properties[0] = "unused"
+		}
+	}
+
+	for _, issue := range report.Issues {
+		issue.Feature.Support[OutlookWindows] = Supported
+	}
+
+	checked, err := Check(result, OutlookWindows)
+
+	if err != nil {
+		t.Fatalf("Error checking template: %s", err)
+	}
+
+	if len(report.Issues) == 0 || len(checked.Issues) != len(report.Issues) {
+		t.Errorf("Expected features returned to callers not to change the dataset, got %d issues instead of %d", len(checked.Issues), len(report.Issues))
+	}
+}
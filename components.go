@@ -0,0 +1,72 @@
+package mjml
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ErrComponentsNotSupported is returned when templates are compiled with custom components registered, but the embedded
+// wasm module was built before js/src/lib.js supported them and has to be rebuilt using js/build.sh
+var ErrComponentsNotSupported = errors.New("custom components are not supported by the embedded wasm module, rebuild it using js/build.sh")
+
+// componentsMarker is part of an error message of js/src/lib.js that is only found in wasm modules supporting custom
+// components
+const componentsMarker = "error registering component"
+
+// componentsSupported reports whether the embedded wasm module supports custom components
+var componentsSupported bool
+
+type customComponent struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+var (
+	customComponentsMu sync.RWMutex
+	customComponents   []customComponent
+)
+
+// RegisterComponent registers a custom MJML component implemented in JavaScript, in the same way as registerComponent
+// is used with the MJML JavaScript library. The source is evaluated in each wasm instance before it compiles its first
+// template with BodyComponent, HeadComponent, registerComponent and registerDependencies in scope:
+//
+//	class MjProductCard extends BodyComponent {
+//	  static componentName = 'mj-product-card'
+//	  ...
+//	}
+//	registerComponent(MjProductCard)
+//	registerDependencies({ 'mj-column': ['mj-product-card'], 'mj-product-card': [] })
+//
+// Components cannot be unregistered from instances that have already evaluated them, so registering the same name
+// twice returns an error. Compiling templates while components are registered returns ErrComponentsNotSupported if the
+// embedded wasm module predates support for custom components.
+func RegisterComponent(name string, source string) error {
+	if name == "" {
+		return errors.New("component name is required")
+	}
+
+	customComponentsMu.Lock()
+	defer customComponentsMu.Unlock()
+
+	for _, component := range customComponents {
+		if component.Name == name {
+			return fmt.Errorf("component %s is already registered", name)
+		}
+	}
+
+	customComponents = append(customComponents, customComponent{
+		Name:   name,
+		Source: source,
+	})
+
+	return nil
+}
+
+func registeredComponents() []customComponent {
+	customComponentsMu.RLock()
+	defer customComponentsMu.RUnlock()
+
+	return slices.Clone(customComponents)
+}
@@ -0,0 +1,97 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegisterComponent(t *testing.T) {
+
+	defer func() {
+		customComponents = nil
+	}()
+
+	source := `class MjProductCard extends BodyComponent {}
+registerComponent(MjProductCard)`
+
+	if err := RegisterComponent("mj-product-card", source); err != nil {
+		t.Fatalf("Error registering component: %s", err)
+	}
+
+	if err := RegisterComponent("mj-product-card", source); err == nil {
+		t.Error("Expected an error when registering a component twice")
+	}
+
+	if err := RegisterComponent("", source); err == nil {
+		t.Error("Expected an error when registering a component without a name")
+	}
+
+	expected := []customComponent{
+		{Name: "mj-product-card", Source: source},
+	}
+
+	if !reflect.DeepEqual(registeredComponents(), expected) {
+		t.Errorf("Registered components do not match expected components: %v", registeredComponents())
+	}
+
+	registered := registeredComponents()
+	registered[0].Name = "mj-changed"
+
+	if customComponents[0].Name != "mj-product-card" {
+		t.Error("Expected registered components to be returned as a copy")
+	}
+}
+
+func TestCompileRegisteredComponent(t *testing.T) {
+
+	defer func() {
+		customComponentsMu.Lock()
+		customComponents = nil
+		customComponentsMu.Unlock()
+	}()
+
+	source := `class MjProductCard extends BodyComponent {
+  static componentName = 'mj-product-card'
+  static endingTag = true
+  render() {
+    return this.renderMJML('<mj-text css-class="product-card">' + this.getContent() + '</mj-text>')
+  }
+}
+registerComponent(MjProductCard)
+registerDependencies({ 'mj-column': ['mj-product-card'], 'mj-product-card': [] })`
+
+	if err := RegisterComponent("mj-product-card", source); err != nil {
+		t.Fatalf("Error registering component: %s", err)
+	}
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-product-card>Blue shoes</mj-product-card>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	output, err := ToHTML(context.Background(), input, WithValidationLevel(Strict))
+
+	if !componentsSupported {
+		if !errors.Is(err, ErrComponentsNotSupported) {
+			t.Errorf("Expected ErrComponentsNotSupported from a wasm module without custom components, got: %v", err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("Error compiling template with a custom component: %s", err)
+	}
+
+	if !strings.Contains(output, `class="product-card"`) || !strings.Contains(output, "Blue shoes") {
+		t.Errorf("Expected the custom component to be rendered, got: %s", output)
+	}
+}
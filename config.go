@@ -0,0 +1,301 @@
+package mjml
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configOptions converts the values of the options block of a config into options, using the names of mjml2html
+var configOptions = map[string]func(value interface{}) (ToHTMLOption, error){
+	"beautify":          boolOption(WithBeautify),
+	"beautifyOptions":   beautifyOptionsOption,
+	"fonts":             fontsOption,
+	"ignoreIncludes":    boolOption(WithIgnoreIncludes),
+	"juiceOptions":      juiceOptionsOption,
+	"juicePreserveTags": juicePreserveTagsOption,
+	"keepComments":      boolOption(WithKeepComments),
+	"minify":            boolOption(WithMinify),
+	"minifyOptions":     minifyOptionsOption,
+	"preprocessors":     preprocessorsOption,
+	"validationLevel":   validationLevelOption,
+}
+
+// configSections converts the sections of a config that are not part of .mjmlconfig into options
+var configSections = map[string]func(value interface{}) (ToHTMLOption, error){
+	"beautify":        beautifyOptionsOption,
+	"fonts":           fontsOption,
+	"juice":           juiceOptionsOption,
+	"minify":          minifyOptionsOption,
+	"validationLevel": validationLevelOption,
+}
+
+// LoadOptions reads options from a JSON or YAML config. The config uses the format of .mjmlconfig files, where the
+// options block uses the names of the mjml2html options:
+//
+//	{"options": {"minify": true, "minifyOptions": {"collapseWhitespace": true}, "validationLevel": "strict"}}
+//
+// The juice, minify and beautify sections set the options of juice, html-minifier and js-beautify, and the fonts and
+// validationLevel sections are equivalent to the options of the same names. Options set in sections replace the ones
+// set in the options block:
+//
+//	minify:
+//	  collapseWhitespace: true
+//	beautify:
+//	  indent_size: 4
+//	validationLevel: soft
+//
+// Packages of custom components cannot be loaded by the WebAssembly module, so configs with packages are rejected.
+// Use RegisterComponent instead.
+func LoadOptions(r io.Reader) ([]ToHTMLOption, error) {
+	var config map[string]interface{}
+
+	if err := yaml.NewDecoder(r).Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error decoding config: %w", err)
+	}
+
+	if packages, ok := config["packages"].([]interface{}); config["packages"] != nil && (!ok || len(packages) > 0) {
+		return nil, errors.New("packages are not supported, register custom components using RegisterComponent")
+	}
+
+	block, ok := config["options"].(map[string]interface{})
+
+	if !ok && config["options"] != nil {
+		return nil, errors.New("options: must be an object")
+	}
+
+	var options []ToHTMLOption
+
+	for _, name := range sortedKeys(block) {
+		option, err := configOption(configOptions, name, "options."+name, block[name])
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, option)
+	}
+
+	for _, section := range sortedKeys(config) {
+		if section == "options" || section == "packages" {
+			continue
+		}
+
+		option, err := configOption(configSections, section, section, config[section])
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, option)
+	}
+
+	return options, nil
+}
+
+// MarshalOptions returns the JSON config containing options, which can be read using LoadOptions. Options applied in
+// Go rather than by mjml, such as themes, includes, components and skeletons, and raw options that LoadOptions does not
+// read cannot be marshaled.
+func MarshalOptions(toHTMLOptions ...ToHTMLOption) ([]byte, error) {
+	o := newOptions()
+
+	for _, opt := range toHTMLOptions {
+		opt(o)
+	}
+
+	if o.local.appliedInGo() {
+		return nil, errors.New("options applied in Go cannot be marshaled")
+	}
+
+	// raw options can have any name, but only the ones LoadOptions reads are marshaled
+	for _, name := range sortedKeys(o.data) {
+		if _, ok := configOptions[name]; !ok {
+			return nil, fmt.Errorf("option %s cannot be marshaled", name)
+		}
+	}
+
+	// LoadOptions rejects preprocessors passed as strings
+	if preprocessors, ok := o.data["preprocessors"]; ok {
+		if err := checkPreprocessors(preprocessors); err != nil {
+			return nil, fmt.Errorf("option preprocessors cannot be marshaled: %w", err)
+		}
+	}
+
+	return json.MarshalIndent(map[string]interface{}{"options": o.data}, "", "  ")
+}
+
+// configOption converts the value of the option called name, which is at path in a config
+func configOption(converters map[string]func(interface{}) (ToHTMLOption, error), name string, path string, value interface{}) (ToHTMLOption, error) {
+	convert, ok := converters[name]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown option %s", path)
+	}
+
+	option, err := convert(value)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: %w", path, err)
+	}
+
+	return option, nil
+}
+
+func boolOption(option func(bool) ToHTMLOption) func(interface{}) (ToHTMLOption, error) {
+	return func(value interface{}) (ToHTMLOption, error) {
+		var b bool
+
+		if err := convertValue(value, &b); err != nil {
+			return nil, err
+		}
+
+		return option(b), nil
+	}
+}
+
+func beautifyOptionsOption(value interface{}) (ToHTMLOption, error) {
+	beautifyOptions, err := applyValues(NewBeautifyOptions(), value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return WithBeautifyOptions(beautifyOptions), nil
+}
+
+func juiceOptionsOption(value interface{}) (ToHTMLOption, error) {
+	juiceOptions, err := applyValues(NewJuiceOptions(), value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return WithJuiceOptions(juiceOptions), nil
+}
+
+func minifyOptionsOption(value interface{}) (ToHTMLOption, error) {
+	minifyOptions, err := applyValues(NewHTMLMinifierOptions(), value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return WithMinifyOptions(minifyOptions), nil
+}
+
+func fontsOption(value interface{}) (ToHTMLOption, error) {
+	var fonts Fonts
+
+	if err := convertValue(value, &fonts); err != nil {
+		return nil, err
+	}
+
+	return WithFonts(fonts), nil
+}
+
+func juicePreserveTagsOption(value interface{}) (ToHTMLOption, error) {
+	var tags map[string]JuiceTag
+
+	if err := convertValue(value, &tags); err != nil {
+		return nil, err
+	}
+
+	return WithJuicePreserveTags(tags), nil
+}
+
+func preprocessorsOption(value interface{}) (ToHTMLOption, error) {
+	var preprocessors []string
+
+	if err := convertValue(value, &preprocessors); err != nil {
+		return nil, err
+	}
+
+	if err := checkPreprocessors(preprocessors); err != nil {
+		return nil, err
+	}
+
+	return WithPreprocessors(preprocessors), nil
+}
+
+func validationLevelOption(value interface{}) (ToHTMLOption, error) {
+	var level ValidationLevel
+
+	if err := convertValue(value, &level); err != nil {
+		return nil, err
+	}
+
+	switch level {
+	case Strict, Soft, Skip:
+		return WithValidationLevel(level), nil
+	}
+
+	return nil, fmt.Errorf("unknown validation level %s", level)
+}
+
+// applyValues calls the setters of an options builder for each key of an object. Keys are matched to setters
+// ignoring case and underscores, so both the camel case keys of juice and html-minifier and the snake case keys of
+// js-beautify can be used.
+func applyValues[T any](builder T, value interface{}) (T, error) {
+	var values map[string]json.RawMessage
+
+	if err := convertValue(value, &values); err != nil {
+		return builder, err
+	}
+
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		v := reflect.ValueOf(builder)
+		method, ok := findSetter(v, key)
+
+		if !ok {
+			return builder, fmt.Errorf("unknown option %s", key)
+		}
+
+		arg := reflect.New(method.Type().In(0))
+
+		if err := json.Unmarshal(values[key], arg.Interface()); err != nil {
+			return builder, fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+
+		builder = method.Call([]reflect.Value{arg.Elem()})[0].Interface().(T)
+	}
+
+	return builder, nil
+}
+
+func findSetter(v reflect.Value, key string) (reflect.Value, bool) {
+	normalized := strings.ReplaceAll(strings.ToLower(key), "_", "")
+
+	for i := 0; i < v.NumMethod(); i++ {
+		if strings.ToLower(v.Type().Method(i).Name) == normalized {
+			return v.Method(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// convertValue converts a value decoded from a config into target, which is decoded as JSON so that values are
+// checked the same way regardless of the format of the config
+func convertValue(value interface{}, target interface{}) error {
+	encoded, err := json.Marshal(value)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, target)
+}
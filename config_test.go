@@ -0,0 +1,192 @@
+package mjml
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func loadData(t *testing.T, config string) map[string]interface{} {
+	t.Helper()
+
+	toHTMLOptions, err := LoadOptions(strings.NewReader(config))
+
+	if err != nil {
+		t.Fatalf("Error loading options: %s", err)
+	}
+
+	o := newOptions()
+
+	for _, option := range toHTMLOptions {
+		option(o)
+	}
+
+	return o.data
+}
+
+func TestLoadOptions(t *testing.T) {
+
+	yamlConfig := `
+options:
+  keepComments: false
+  minify: true
+  validationLevel: strict
+  fonts:
+    Raleway: https://fonts.googleapis.com/css?family=Raleway
+minify:
+  collapseWhitespace: true
+  ignoreCustomFragments: ["<#.*#>"]
+  quoteCharacter: "'"
+beautify:
+  indent_size: 4
+  wrap_attributes: force
+  templating: [django]
+juice:
+  preserveMediaQueries: true
+validationLevel: soft
+`
+
+	expected := map[string]interface{}{
+		"keepComments":    false,
+		"minify":          true,
+		"validationLevel": Soft,
+		"fonts":           Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"},
+		"minifyOptions": map[string]interface{}{
+			"collapseWhitespace":    true,
+			"ignoreCustomFragments": []string{"<#.*#>"},
+			"quoteCharacter":        HTMLMinifierSingleQuote,
+		},
+		"beautifyOptions": map[string]interface{}{
+			"indent_size":     uint(4),
+			"wrap_attributes": BeautifyWrapAttributesForce,
+			"templating":      []BeautifyTemplating{BeautifyTemplatingDjango},
+		},
+		"juiceOptions": map[string]interface{}{
+			"preserveMediaQueries": true,
+		},
+	}
+
+	if data := loadData(t, yamlConfig); !reflect.DeepEqual(data, expected) {
+		t.Errorf("Options loaded from YAML do not match expected options: %#v", data)
+	}
+
+	jsonConfig := `{
+  "options": {
+    "beautify": true,
+    "beautifyOptions": {"indent_size": 4, "wrap_attributes": "force", "templating": ["django"]},
+    "juicePreserveTags": {"myTag": {"start": "<#", "end": "</#"}}
+  },
+  "packages": []
+}`
+
+	expected = map[string]interface{}{
+		"beautify": true,
+		"beautifyOptions": map[string]interface{}{
+			"indent_size":     uint(4),
+			"wrap_attributes": BeautifyWrapAttributesForce,
+			"templating":      []BeautifyTemplating{BeautifyTemplatingDjango},
+		},
+		"juicePreserveTags": map[string]JuiceTag{"myTag": {Start: "<#", End: "</#"}},
+	}
+
+	if data := loadData(t, jsonConfig); !reflect.DeepEqual(data, expected) {
+		t.Errorf("Options loaded from JSON do not match expected options: %#v", data)
+	}
+
+	if data := loadData(t, ""); len(data) != 0 {
+		t.Errorf("Expected an empty config to have no options: %#v", data)
+	}
+}
+
+func TestLoadOptionsErrors(t *testing.T) {
+
+	tests := map[string]string{
+		`options: {minfy: true}`:                     "unknown option options.minfy",
+		`options: {minify: "yes"}`:                   "invalid value for options.minify",
+		`options: [minify]`:                          "options: must be an object",
+		`validationLevel: lenient`:                   "invalid value for validationLevel: unknown validation level lenient",
+		`beautify: {indent_size: four}`:              "invalid value for beautify: invalid value for indent_size",
+		`juice: {preserveEverything: true}`:          "invalid value for juice: unknown option preserveEverything",
+		`packages: ["mjml-column-responsive"]`:       "packages are not supported",
+		`options: {preprocessors: ["(xml) => xml"]}`: "invalid value for options.preprocessors: not supported",
+		`theme: dark`:                                "unknown option theme",
+		`options: {minify: true`:                     "error decoding config",
+		`{"options": {"minifyOptions": "none"}}`:     "invalid value for options.minifyOptions",
+		`{"options": {"fonts": ["Raleway"]}}`:        "invalid value for options.fonts",
+		`{"options": {"juicePreserveTags": true}}`:   "invalid value for options.juicePreserveTags",
+	}
+
+	for config, expected := range tests {
+		_, err := LoadOptions(strings.NewReader(config))
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing %q for %s, got: %v", expected, config, err)
+		}
+	}
+}
+
+func TestMarshalOptions(t *testing.T) {
+
+	toHTMLOptions := []ToHTMLOption{
+		WithBeautify(true),
+		WithBeautifyOptions(NewBeautifyOptions().IndentSize(4).BraceStyle(BeautifyBraceStyleExpand).Templating([]BeautifyTemplating{BeautifyTemplatingERB})),
+		WithFonts(Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"}),
+		WithJuiceOptions(NewJuiceOptions().PreserveKeyFrames(true).ExtraCss("p { color: red; }")),
+		WithJuicePreserveTags(map[string]JuiceTag{"myTag": {Start: "<#", End: "</#"}}),
+		WithKeepComments(false),
+		WithMinify(true),
+		WithMinifyOptions(NewHTMLMinifierOptions().HTML5(true).MinifyURLs(true).MaxLineLength(80).QuoteCharacter(HTMLMinifierDoubleQuote)),
+		WithPreprocessors([]string{}),
+		WithIgnoreIncludes(true),
+		WithOptions(NewOptions(WithRawOptions(map[string]interface{}{"keepComments": true}))),
+		WithValidationLevel(Skip),
+	}
+
+	config, err := MarshalOptions(toHTMLOptions...)
+
+	if err != nil {
+		t.Fatalf("Error marshaling options: %s", err)
+	}
+
+	expected := newOptions()
+
+	for _, option := range toHTMLOptions {
+		option(expected)
+	}
+
+	if data := loadData(t, string(config)); !reflect.DeepEqual(data, expected.data) {
+		t.Errorf("Options loaded from marshaled config do not match the original options: %s", config)
+	}
+
+	unsupported := map[string]ToHTMLOption{
+		"WithBreakpoint":     WithBreakpoint("480px"),
+		"WithComponents":     WithComponents(productComponent),
+		"WithDarkMode":       WithDarkMode(DarkPalette{"#ffffff": "#000000"}),
+		"WithFilePath":       WithFilePath("template.mjml"),
+		"WithFooterHTML":     WithFooterHTML("<p>Footer</p>"),
+		"WithHTMLAttributes": WithHTMLAttributes(".link a", map[string]string{"target": "_blank"}),
+		"WithIncludeFS":      WithIncludeFS(fstest.MapFS{}),
+		"WithInlineImages":   WithInlineImages(FSImageLoader(fstest.MapFS{})),
+		"WithLinkRewriter":   WithLinkRewriter(func(link LinkInfo) string { return link.URL }),
+		"WithPostprocessor":  WithPostprocessor(func(ctx context.Context, html string) (string, error) { return html, nil }),
+		"WithPreprocessor":   WithPreprocessor(func(ctx context.Context, mjml string) (string, error) { return mjml, nil }),
+		"WithPreprocessors":  WithPreprocessors([]string{"(xml) => xml"}),
+		"WithPreview":        WithPreview("Preview"),
+		"WithRawOptions":     WithRawOptions(map[string]interface{}{"filePath": "template.mjml"}),
+		"WithSizeBudget":     WithSizeBudget(100000, Strict),
+		"WithSkeleton":       WithSkeleton("{{.HTML}}"),
+		"WithStyle":          WithStyle("p { color: red; }", false),
+		"WithTextWidth":      WithTextWidth(60),
+		"WithTheme":          WithTheme(Theme{FontFamily: "Arial"}),
+		"WithTitle":          WithTitle("Title"),
+		"WithTrackingPixel":  WithTrackingPixel("https://example.com/pixel.gif"),
+	}
+
+	for name, option := range unsupported {
+		if _, err := MarshalOptions(option); err == nil {
+			t.Errorf("Expected an error marshaling options set using %s, which LoadOptions cannot read", name)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package mjml
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// DarkPalette maps the colors of a template to the colors used in dark mode, for example {"#ffffff": "#121212"}
+type DarkPalette map[string]string
+
+// WithDarkMode adds dark mode variants of the colors of the template. Components whose color, background-color,
+// container-background-color or border-color attributes are in the palette, including values set using
+// mj-attributes and the defaults of mjml, are given a css-class with rules for the dark colors in a
+// prefers-color-scheme: dark media query, and in [data-ogsc] and [data-ogsb] selectors for Outlook.com. The
+// color-scheme meta tags are added to the head, and the juice option PreserveMediaQueries is enabled so that the media
+// query is kept.
+func WithDarkMode(palette DarkPalette) ToHTMLOption {
+	palette = maps.Clone(palette)
+
+	return func(o options) {
+		o.local.darkPalette = palette
+	}
+}
+
+// darkModeDefaults are the defaults of mjml for the color attributes of components
+var darkModeDefaults = map[string]map[string]string{
+	"mj-button":      {"color": "#ffffff", "background-color": "#414141"},
+	"mj-divider":     {"border-color": "#000000"},
+	"mj-navbar-link": {"color": "#000000"},
+	"mj-table":       {"color": "#000000"},
+	"mj-text":        {"color": "#000000"},
+}
+
+// darkModeSelectors are the selectors of the elements styled by the color attributes of components, relative to the
+// element with the css-class of the component. Outlook.com marks elements whose color is changed in dark mode using
+// data-ogsc, and elements whose background is changed using data-ogsb.
+var darkModeSelectors = map[string]map[string]string{
+	"mj-body":        {"background-color": "body, .%[1]s"},
+	"mj-wrapper":     {"background-color": ".%[1]s, .%[1]s > table"},
+	"mj-section":     {"background-color": ".%[1]s, .%[1]s > table"},
+	"mj-column":      {"background-color": ".%[1]s > table"},
+	"mj-text":        {"color": ".%[1]s div"},
+	"mj-button":      {"color": ".%[1]s a, .%[1]s p", "background-color": ".%[1]s td, .%[1]s a, .%[1]s p"},
+	"mj-navbar-link": {"color": ".%[1]s"},
+	"mj-table":       {"color": ".%[1]s table"},
+	"mj-divider":     {"border-color": ".%[1]s p"},
+}
+
+// darkModeLeaves are the components placed in a td with their css-class, whose container-background-color is
+// the background of the td
+var darkModeLeaves = []string{"mj-button", "mj-divider", "mj-image", "mj-social", "mj-spacer", "mj-table", "mj-text"}
+
+// darkModeProperties are the CSS properties set by the color attributes
+var darkModeProperties = map[string]string{
+	"color":                      "color",
+	"background-color":           "background-color",
+	"container-background-color": "background-color",
+	"border-color":               "border-top-color",
+}
+
+const darkModeMeta = `<meta name="color-scheme" content="light dark"><meta name="supported-color-schemes" content="light dark">`
+
+type darkModeRule struct {
+	selector   string
+	property   string
+	color      string
+	background bool
+}
+
+// applyDarkMode adds the css-class of components with colors in the palette, and the mj-style with the dark colors
+func applyDarkMode(root *ast.Node, palette DarkPalette) {
+	if palette == nil {
+		return
+	}
+
+	normalized := make(map[string]string, len(palette))
+
+	for light, dark := range palette {
+		normalized[normalizeColor(light)] = dark
+	}
+
+	body := root.Child("mj-body")
+
+	if body == nil {
+		return
+	}
+
+	declared := ast.NewAttributes(root)
+
+	var rules []darkModeRule
+	classes := 0
+
+	body.Walk(func(n *ast.Node) bool {
+		if n.Type != ast.ElementNode {
+			return false
+		}
+
+		selectors := map[string]string{}
+
+		for name, selector := range darkModeSelectors[n.Tag] {
+			selectors[name] = selector
+		}
+
+		if slices.Contains(darkModeLeaves, n.Tag) {
+			selectors["container-background-color"] = ".%[1]s"
+		}
+
+		var class string
+
+		for _, name := range sortedKeys(selectors) {
+			value, ok := declared.Get(n, name)
+
+			if !ok {
+				value = darkModeDefaults[n.Tag][name]
+			}
+
+			dark, ok := normalized[normalizeColor(value)]
+
+			if !ok {
+				continue
+			}
+
+			if class == "" {
+				classes++
+				class = fmt.Sprintf("dark-mode-%d", classes)
+
+				if existing, _ := declared.Get(n, "css-class"); existing != "" {
+					n.SetAttr("css-class", existing+" "+class)
+				} else {
+					n.SetAttr("css-class", class)
+				}
+			}
+
+			property := darkModeProperties[name]
+
+			rules = append(rules, darkModeRule{
+				selector:   fmt.Sprintf(selectors[name], class),
+				property:   property,
+				color:      dark,
+				background: property == "background-color",
+			})
+		}
+
+		return true
+	})
+
+	head(root).AppendChild(contentElement("mj-style", darkModeCSS(rules)))
+}
+
+// darkModeCSS returns the style sheet applying the dark colors
+func darkModeCSS(rules []darkModeRule) string {
+	var sb strings.Builder
+
+	sb.WriteString("\n:root { color-scheme: light dark; supported-color-schemes: light dark; }\n")
+
+	if len(rules) == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("@media (prefers-color-scheme: dark) {\n")
+
+	for _, rule := range rules {
+		fmt.Fprintf(&sb, "  %s { %s: %s !important; }\n", rule.selector, rule.property, rule.color)
+	}
+
+	sb.WriteString("}\n")
+
+	for _, rule := range rules {
+		attribute := "[data-ogsc]"
+
+		if rule.background {
+			attribute = "[data-ogsb]"
+		}
+
+		selectors := strings.Split(rule.selector, ", ")
+
+		for i, selector := range selectors {
+			selectors[i] = attribute + " " + selector
+		}
+
+		fmt.Fprintf(&sb, "%s { %s: %s !important; }\n", strings.Join(selectors, ", "), rule.property, rule.color)
+	}
+
+	return sb.String()
+}
+
+// injectColorScheme adds the color-scheme meta tags to the head of the html
+func (l *localOptions) injectColorScheme(html string) string {
+	if l.darkPalette == nil {
+		return html
+	}
+
+	return strings.Replace(html, "<head>", "<head>"+darkModeMeta, 1)
+}
+
+// preserveMediaQueries enables the PreserveMediaQueries juice option for dark mode, keeping the other juice options
+func (o options) preserveMediaQueries() {
+	if o.local.darkPalette == nil {
+		return
+	}
+
+	juiceOptions, ok := o.data["juiceOptions"].(map[string]interface{})
+
+	if !ok && o.data["juiceOptions"] != nil {
+		return
+	}
+
+	juiceOptions = maps.Clone(juiceOptions)
+
+	if juiceOptions == nil {
+		juiceOptions = map[string]interface{}{}
+	}
+
+	juiceOptions["preserveMediaQueries"] = true
+	o.data["juiceOptions"] = juiceOptions
+}
+
+// normalizeColor lowercases hex colors and expands their short form
+func normalizeColor(color string) string {
+	color = strings.ToLower(strings.TrimSpace(color))
+
+	if len(color) == 4 && color[0] == '#' {
+		return string([]byte{'#', color[1], color[1], color[2], color[2], color[3], color[3]})
+	}
+
+	return color
+}
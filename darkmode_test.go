@@ -0,0 +1,82 @@
+package mjml
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDarkMode(t *testing.T) {
+
+	input := `<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-class name="muted" color="#333" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body background-color="#FFFFFF">
+    <mj-section background-color="#f4f4f4">
+      <mj-column>
+        <mj-text mj-class="muted" css-class="intro">Hello</mj-text>
+        <mj-button href="https://example.com">Shop now</mj-button>
+        <mj-text color="#ff0000">Unchanged</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	palette := DarkPalette{"#fff": "#121212", "#F4F4F4": "#1e1e1e", "#333333": "#eeeeee", "#414141": "#bb86fc"}
+
+	output, err := ToHTML(context.Background(), input, WithDarkMode(palette), WithMinify(true))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	expected := []string{
+		`<head><meta name="color-scheme" content="light dark"><meta name="supported-color-schemes" content="light dark">`,
+		`:root { color-scheme: light dark; supported-color-schemes: light dark; }`,
+		`@media (prefers-color-scheme: dark) {`,
+		`body, .dark-mode-1 { background-color: #121212 !important; }`,
+		`.dark-mode-2, .dark-mode-2 > table { background-color: #1e1e1e !important; }`,
+		`.dark-mode-3 div { color: #eeeeee !important; }`,
+		`.dark-mode-4 a, .dark-mode-4 p { color: #121212 !important; }`,
+		`[data-ogsb] body, [data-ogsb] .dark-mode-1 { background-color: #121212 !important; }`,
+		`[data-ogsc] .dark-mode-3 div { color: #eeeeee !important; }`,
+		`<div class="dark-mode-1"`,
+		`<div class="dark-mode-2"`,
+		`class="intro dark-mode-3"`,
+		`class="dark-mode-4"`,
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(output, e) {
+			t.Errorf("Expected output to contain %q, got:\n%s", e, output)
+		}
+	}
+
+	if strings.Contains(output, "dark-mode-5") {
+		t.Errorf("Expected components with colors not in the palette to be unchanged, got:\n%s", output)
+	}
+}
+
+func TestDarkModeJuiceOptions(t *testing.T) {
+
+	o := newOptions()
+
+	WithJuiceOptions(NewJuiceOptions().PreserveImportant(true))(o)
+	shared := o.data["juiceOptions"].(map[string]interface{})
+
+	WithDarkMode(DarkPalette{"#ffffff": "#000000"})(o)
+	o.preserveMediaQueries()
+
+	juiceOptions := o.data["juiceOptions"].(map[string]interface{})
+
+	if juiceOptions["preserveMediaQueries"] != true || juiceOptions["preserveImportant"] != true {
+		t.Errorf("Expected preserveMediaQueries to be added to the juice options, got %v", juiceOptions)
+	}
+
+	if _, ok := shared["preserveMediaQueries"]; ok {
+		t.Error("Expected the juice options passed to WithJuiceOptions not to be modified")
+	}
+}
@@ -0,0 +1,561 @@
+// Package diff compares the html compiled from mjml templates, to review the changes made by upgrading mjml-go,
+// changing options or editing templates. The html is normalized before it is compared, so differences in whitespace,
+// the order of attributes and classes, and the order of style properties are ignored, as they are when the output is
+// beautified:
+//
+//	d, err := diff.CompareOptions(ctx, input, nil, []mjml.ToHTMLOption{mjml.WithMinify(true)})
+//
+//	for _, change := range d.Changes {
+//		fmt.Println(change)
+//	}
+//
+// When mjml is compared, the changes are attributed to the components generating the changed html.
+package diff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// markerPrefix is the prefix of the css-class added to components to find the html they generate
+const markerPrefix = "mjml-diff-"
+
+// ChangeKind is the kind of a change
+type ChangeKind int
+
+const (
+	// Added is an html node that was added
+	Added ChangeKind = iota
+
+	// Removed is an html node that was removed
+	Removed
+
+	// Modified is an html node whose attributes, style properties or text changed
+	Modified
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+
+	case Removed:
+		return "removed"
+	}
+
+	return "modified"
+}
+
+// Change is a difference between the html documents
+type Change struct {
+	Kind ChangeKind
+
+	// Component is the path of the mjml component generating the changed node, such as
+	// mj-section[2] > mj-column > mj-button, or empty if it is not known, for example because html was compared
+	Component string
+
+	// Path is the path of the changed node in the html, such as html > body > div > table
+	Path string
+
+	// Attribute is the changed attribute of a modified element, including "style" when a style property changed. It is
+	// empty when the text or a comment changed.
+	Attribute string
+
+	// Property is the changed style property
+	Property string
+
+	// Before and After are the values before and after the change. For added and removed nodes, they are the opening
+	// tag, text or comment in canonical form. They are empty if the attribute or property was added or removed.
+	Before string
+	After  string
+}
+
+// String describes the change on a single line
+func (c Change) String() string {
+	location := c.Component
+
+	if location == "" {
+		location = c.Path
+	}
+
+	switch {
+	case c.Kind == Added:
+		return fmt.Sprintf("%s: added %s", location, c.After)
+
+	case c.Kind == Removed:
+		return fmt.Sprintf("%s: removed %s", location, c.Before)
+
+	case c.Property != "":
+		return fmt.Sprintf("%s: style %s %q -> %q", location, c.Property, c.Before, c.After)
+
+	case c.Attribute != "":
+		return fmt.Sprintf("%s: %s %q -> %q", location, c.Attribute, c.Before, c.After)
+	}
+
+	return fmt.Sprintf("%s: %q -> %q", location, c.Before, c.After)
+}
+
+// Count is the number of changes to a component or style property
+type Count struct {
+	Name    string
+	Changes int
+}
+
+// Diff is the difference between two html documents
+type Diff struct {
+	// Changes are the changed nodes, in document order
+	Changes []Change
+
+	before []string
+	after  []string
+}
+
+// Compare compares two html documents
+func Compare(before string, after string) (*Diff, error) {
+	b, err := parse(before, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := parse(after, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return compare(b, a), nil
+}
+
+// CompareMJML compiles two versions of an mjml template using the same options and compares the html
+func CompareMJML(ctx context.Context, before string, after string, options ...mjml.ToHTMLOption) (*Diff, error) {
+	return CompareTemplates(ctx, before, options, after, options)
+}
+
+// CompareOptions compiles an mjml template using two sets of options and compares the html
+func CompareOptions(ctx context.Context, input string, before []mjml.ToHTMLOption, after []mjml.ToHTMLOption) (*Diff, error) {
+	return CompareTemplates(ctx, input, before, input, after)
+}
+
+// CompareTemplates compiles two mjml templates, each using its own options, and compares the html
+func CompareTemplates(ctx context.Context, before string, beforeOptions []mjml.ToHTMLOption, after string, afterOptions []mjml.ToHTMLOption) (*Diff, error) {
+	b, err := compile(ctx, before, beforeOptions)
+
+	if err != nil {
+		return nil, fmt.Errorf("error compiling before: %w", err)
+	}
+
+	a, err := compile(ctx, after, afterOptions)
+
+	if err != nil {
+		return nil, fmt.Errorf("error compiling after: %w", err)
+	}
+
+	return compare(b, a), nil
+}
+
+// compile compiles mjml with its components marked, so the html they generate can be attributed to them
+func compile(ctx context.Context, input string, options []mjml.ToHTMLOption) (*node, error) {
+	marked, components := mark(input)
+
+	output, err := mjml.ToHTML(ctx, marked, options...)
+
+	if err != nil {
+		// the lines of errors refer to the marked mjml, so the original mjml is compiled to report them
+		if _, originalErr := mjml.ToHTML(ctx, input, options...); originalErr != nil {
+			return nil, originalErr
+		}
+
+		return nil, err
+	}
+
+	return parse(output, components)
+}
+
+// mark adds a css-class to the components of the mjml body, returning the marked mjml and the paths of the
+// components identified by the classes. The mjml is returned unchanged if it cannot be parsed, so that mjml reports
+// the error.
+func mark(input string) (string, []string) {
+	root, err := ast.Parse(input)
+
+	if err != nil || root.Tag != "mjml" {
+		return input, nil
+	}
+
+	body := root.Child("mj-body")
+
+	if body == nil {
+		return input, nil
+	}
+
+	declared := ast.NewAttributes(root)
+
+	var components []string
+
+	var walk func(n *ast.Node, path string)
+
+	walk = func(n *ast.Node, path string) {
+		class := fmt.Sprintf("%s%d", markerPrefix, len(components))
+		components = append(components, path)
+
+		if existing, _ := declared.Get(n, "css-class"); existing != "" {
+			class = existing + " " + class
+		}
+
+		n.SetAttr("css-class", class)
+
+		counts := map[string]int{}
+
+		for _, child := range n.Children {
+			counts[child.Tag]++
+		}
+
+		indexes := map[string]int{}
+
+		for _, child := range n.Children {
+			// included components are resolved after marking, and are attributed to the component including them
+			if child.Type != ast.ElementNode || child.Tag == "mj-include" {
+				continue
+			}
+
+			indexes[child.Tag]++
+
+			name := child.Tag
+
+			if counts[child.Tag] > 1 {
+				name += fmt.Sprintf("[%d]", indexes[child.Tag])
+			}
+
+			if n != body {
+				name = path + " > " + name
+			}
+
+			walk(child, name)
+		}
+	}
+
+	walk(body, "mj-body")
+
+	var sb strings.Builder
+
+	if err := root.Render(&sb); err != nil {
+		return input, nil
+	}
+
+	return sb.String(), components
+}
+
+// compare compares documents in canonical form
+func compare(before *node, after *node) *Diff {
+	d := &Diff{
+		before: before.lines(nil, 0),
+		after:  after.lines(nil, 0),
+	}
+
+	if before.hash != after.hash {
+		d.children(before, after)
+	}
+
+	return d
+}
+
+// Equal reports whether the documents are equal once normalized
+func (d *Diff) Equal() bool {
+	return len(d.Changes) == 0
+}
+
+// Components returns the number of changes to each component, in document order. Changes to html that is not
+// attributed to a component are not counted.
+func (d *Diff) Components() []Count {
+	return count(d.Changes, func(c Change) string {
+		return c.Component
+	})
+}
+
+// Properties returns the number of changes to each style property, by decreasing number of changes
+func (d *Diff) Properties() []Count {
+	counts := count(d.Changes, func(c Change) string {
+		return c.Property
+	})
+
+	slices.SortStableFunc(counts, func(a, b Count) int {
+		if a.Changes != b.Changes {
+			return b.Changes - a.Changes
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return counts
+}
+
+func count(changes []Change, name func(Change) string) []Count {
+	var counts []Count
+
+	for _, c := range changes {
+		n := name(c)
+
+		if n == "" {
+			continue
+		}
+
+		i := slices.IndexFunc(counts, func(count Count) bool {
+			return count.Name == n
+		})
+
+		if i < 0 {
+			counts = append(counts, Count{Name: n})
+			i = len(counts) - 1
+		}
+
+		counts[i].Changes++
+	}
+
+	return counts
+}
+
+// children compares the children of nodes. Children that are equal are paired first, and then the remaining
+// children are paired as modified nodes if they are pairable.
+func (d *Diff) children(before *node, after *node) {
+	b, a := before.children, after.children
+
+	var removed, added []*node
+
+	flush := func() {
+		ops := sequence(len(removed), len(added), func(i, j int) bool {
+			return pairable(removed[i], added[j])
+		})
+
+		i, j := 0, 0
+
+		for _, o := range ops {
+			switch o {
+			case keep:
+				d.node(removed[i], added[j])
+				i++
+				j++
+
+			case remove:
+				d.add(Removed, removed[i], removed[i], "", "", removed[i].line(), "")
+				i++
+
+			case insert:
+				d.add(Added, added[j], added[j], "", "", "", added[j].line())
+				j++
+			}
+		}
+
+		removed, added = nil, nil
+	}
+
+	i, j := 0, 0
+
+	for _, o := range sequence(len(b), len(a), func(i, j int) bool { return b[i].hash == a[j].hash }) {
+		switch o {
+		case keep:
+			flush()
+			i++
+			j++
+
+		case remove:
+			removed = append(removed, b[i])
+			i++
+
+		case insert:
+			added = append(added, a[j])
+			j++
+		}
+	}
+
+	flush()
+}
+
+// node compares paired nodes
+func (d *Diff) node(before *node, after *node) {
+	if before.hash == after.hash {
+		return
+	}
+
+	if before.kind != elementNode {
+		d.add(Modified, before, after, "", "", comparable(before), comparable(after))
+		return
+	}
+
+	names := map[string]bool{}
+
+	for _, a := range slices.Concat(before.attributes, after.attributes) {
+		names[a.Key] = true
+	}
+
+	for _, name := range sortedKeys(names) {
+		b, _ := before.attribute(name)
+		a, _ := after.attribute(name)
+
+		if b == a {
+			continue
+		}
+
+		if name != "style" {
+			d.add(Modified, before, after, name, "", b, a)
+			continue
+		}
+
+		bp, ap := properties(b), properties(a)
+		changed := map[string]bool{}
+
+		for property := range bp {
+			changed[property] = true
+		}
+
+		for property := range ap {
+			changed[property] = true
+		}
+
+		for _, property := range sortedKeys(changed) {
+			if bp[property] != ap[property] {
+				d.add(Modified, before, after, name, property, bp[property], ap[property])
+			}
+		}
+	}
+
+	d.children(before, after)
+}
+
+func (d *Diff) add(kind ChangeKind, before *node, after *node, attribute string, property string, b string, a string) {
+	component := after.component
+
+	if component == "" {
+		component = before.component
+	}
+
+	// the elements wrapping the html of a component, such as the row of a column, are added and removed with it
+	if kind != Modified {
+		component = after.innerComponent(component)
+	}
+
+	d.Changes = append(d.Changes, Change{
+		Kind:      kind,
+		Component: component,
+		Path:      after.path,
+		Attribute: attribute,
+		Property:  property,
+		Before:    b,
+		After:     a,
+	})
+}
+
+// comparable returns the canonical form of a text or comment node on one line
+func comparable(n *node) string {
+	return strings.Join(slices.Concat([]string{n.text}, n.tokens, []string{n.end}), "")
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// WriteUnified writes the difference between the canonical forms of the documents as a unified diff with three lines
+// of context. Nothing is written if the documents are equal.
+func (d *Diff) WriteUnified(w io.Writer, beforeName string, afterName string) error {
+	if d.Equal() {
+		return nil
+	}
+
+	const context = 3
+
+	ops := sequence(len(d.before), len(d.after), func(i, j int) bool {
+		return d.before[i] == d.after[j]
+	})
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", beforeName, afterName)
+
+	// positions of each operation in the documents
+	beforeLines := make([]int, len(ops)+1)
+	afterLines := make([]int, len(ops)+1)
+
+	for k, o := range ops {
+		beforeLines[k+1], afterLines[k+1] = beforeLines[k], afterLines[k]
+
+		if o != insert {
+			beforeLines[k+1]++
+		}
+
+		if o != remove {
+			afterLines[k+1]++
+		}
+	}
+
+	for start := 0; start < len(ops); {
+		if ops[start] == keep {
+			start++
+			continue
+		}
+
+		// extend the hunk while changes are separated by at most twice the context
+		end := start
+
+		for k := start; k < len(ops); k++ {
+			if ops[k] != keep {
+				end = k + 1
+				continue
+			}
+
+			if k-end >= 2*context {
+				break
+			}
+		}
+
+		from := max(0, start-context)
+		to := min(len(ops), end+context)
+
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			hunkRange(beforeLines[from], beforeLines[to]-beforeLines[from]),
+			hunkRange(afterLines[from], afterLines[to]-afterLines[from]))
+
+		for k := from; k < to; k++ {
+			switch ops[k] {
+			case keep:
+				sb.WriteString(" " + d.before[beforeLines[k]] + "\n")
+
+			case remove:
+				sb.WriteString("-" + d.before[beforeLines[k]] + "\n")
+
+			case insert:
+				sb.WriteString("+" + d.after[afterLines[k]] + "\n")
+			}
+		}
+
+		start = to
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func hunkRange(start int, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	if length == 1 {
+		return fmt.Sprint(start + 1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
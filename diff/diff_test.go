@@ -0,0 +1,168 @@
+package diff
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Boostport/mjml-go"
+)
+
+func TestCompare(t *testing.T) {
+
+	before := `<!doctype html><html><head><style>.a { color: red; }</style></head><body>
+  <table border="0" role="presentation"><tr><td class="b a" style="padding:0; color: #000">Hello   world</td></tr></table>
+  <!--[if mso]><table role="presentation" width="600"><tr><td><![endif]-->
+</body></html>`
+
+	equivalent := `<!doctype html><html><head><style>.a{color:red}</style></head><body><table role="presentation" border="0"><tr><td style="color:#000;padding:0" class="a b">
+Hello world</td></tr></table><!--[if mso]>
+<table width="600" role="presentation"><tr><td>
+<![endif]--></body></html>`
+
+	d, err := Compare(before, equivalent)
+
+	if err != nil {
+		t.Fatalf("Error comparing html: %s", err)
+	}
+
+	if !d.Equal() {
+		t.Errorf("Expected documents differing in whitespace and order to be equal, got changes %v", d.Changes)
+	}
+
+	changed := `<!doctype html><html><head><style>.a { color: blue; }</style></head><body>
+  <table border="0" role="presentation"><tr><td class="a" style="padding:0; color: #333">Hello world</td></tr></table>
+  <p>Added</p>
+  <!--[if mso]><table role="presentation" width="500"><tr><td><![endif]-->
+</body></html>`
+
+	d, err = Compare(before, changed)
+
+	if err != nil {
+		t.Fatalf("Error comparing html: %s", err)
+	}
+
+	expected := []Change{
+		{Kind: Modified, Path: "html > head > style", Before: ".a{color:red}", After: ".a{color:blue}"},
+		{Kind: Modified, Path: "html > body > table > tbody > tr > td", Attribute: "class", Before: "a b", After: "a"},
+		{Kind: Modified, Path: "html > body > table > tbody > tr > td", Attribute: "style", Property: "color", Before: "#000", After: "#333"},
+		{Kind: Added, Path: "html > body > p", After: "<p>"},
+		{Kind: Modified, Path: "html > body", Before: `<!--[if mso]><table role="presentation" width="600"><tr><td><![endif]-->`, After: `<!--[if mso]><table role="presentation" width="500"><tr><td><![endif]-->`},
+	}
+
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("Unexpected changes:\n%v\nexpected:\n%v", d.Changes, expected)
+	}
+
+	if properties := d.Properties(); !reflect.DeepEqual(properties, []Count{{Name: "color", Changes: 1}}) {
+		t.Errorf("Unexpected properties: %v", properties)
+	}
+
+	var sb strings.Builder
+
+	if err := d.WriteUnified(&sb, "before.html", "after.html"); err != nil {
+		t.Fatalf("Error writing diff: %s", err)
+	}
+
+	unified := sb.String()
+
+	for _, line := range []string{
+		"--- before.html\n+++ after.html\n@@ -2,21 +2,24 @@\n <html>\n",
+		"\n-      .a{color:red}\n+      .a{color:blue}\n",
+		`-          <td class="a b" style="color:#000;padding:0">`,
+		`+          <td class="a" style="color:#333;padding:0">`,
+		"\n+    <p>\n+      Added\n+    </p>\n",
+		`+      <table role="presentation" width="500">`,
+	} {
+		if !strings.Contains(unified, line) {
+			t.Errorf("Expected unified diff to contain %q, got:\n%s", line, unified)
+		}
+	}
+}
+
+func TestCompareMJML(t *testing.T) {
+
+	before := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text css-class="intro">Hello</mj-text>
+        <mj-button href="https://example.com">Shop</mj-button>
+      </mj-column>
+    </mj-section>
+    <mj-section>
+      <mj-column>
+        <mj-image src="https://example.com/image.png" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	after := strings.NewReplacer(
+		`<mj-text css-class="intro">`, `<mj-text css-class="intro" color="#333333">`,
+		`<mj-image src="https://example.com/image.png" />`, `<mj-image src="https://example.com/image.png" /><mj-divider />`,
+	).Replace(before)
+
+	d, err := CompareMJML(context.Background(), before, after)
+
+	if err != nil {
+		t.Fatalf("Error comparing mjml: %s", err)
+	}
+
+	expected := []Count{
+		{Name: "mj-section[1] > mj-column > mj-text", Changes: 1},
+		{Name: "mj-section[2] > mj-column > mj-divider", Changes: 1},
+	}
+
+	if components := d.Components(); !reflect.DeepEqual(components, expected) {
+		t.Errorf("Unexpected components: %v, changes: %v", components, d.Changes)
+	}
+
+	if d.Changes[0].Property != "color" || d.Changes[0].Before != "#000000" || d.Changes[0].After != "#333333" {
+		t.Errorf("Unexpected change: %s", d.Changes[0])
+	}
+
+	for _, change := range d.Changes {
+		if strings.Contains(change.String(), markerPrefix) {
+			t.Errorf("Expected marker classes to be removed, got: %s", change)
+		}
+	}
+
+	if _, err := CompareMJML(context.Background(), before, "<mjml><mj-body><mj-section><mj-text>Invalid</mj-text></mj-section></mj-body></mjml>", mjml.WithValidationLevel(mjml.Strict)); err == nil {
+		t.Error("Expected an error compiling an invalid template")
+	}
+}
+
+func TestCompareOptions(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text>Hello</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	d, err := CompareOptions(context.Background(), input, nil, []mjml.ToHTMLOption{mjml.WithBeautify(true)})
+
+	if err != nil {
+		t.Fatalf("Error comparing options: %s", err)
+	}
+
+	if !d.Equal() {
+		t.Errorf("Expected beautified output to be equal, got changes %v", d.Changes)
+	}
+
+	d, err = CompareOptions(context.Background(), input, nil, []mjml.ToHTMLOption{mjml.WithFonts(map[string]string{})})
+
+	if err != nil {
+		t.Fatalf("Error comparing options: %s", err)
+	}
+
+	if d.Equal() {
+		t.Error("Expected removing the fonts to change the output")
+	}
+}
@@ -0,0 +1,535 @@
+package diff
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+type nodeKind int
+
+const (
+	elementNode nodeKind = iota
+	textNode
+	commentNode
+	doctypeNode
+)
+
+// node is an html node in canonical form, with attributes, classes and style properties sorted and whitespace
+// collapsed
+type node struct {
+	kind nodeKind
+	tag  string
+
+	// attributes are sorted by name. The class and style attributes are normalized, and removed if they are empty.
+	attributes []html.Attribute
+
+	// text is the escaped and collapsed text of text nodes, a rule of style sheets, or the first line of comments
+	text string
+
+	// tokens are the canonical tokens of the html in conditional comments, and end the line closing them
+	tokens []string
+	end    string
+
+	// component is the path of the mjml component generating the node, and path the path of the node in the html
+	component string
+	path      string
+
+	children []*node
+	hash     [sha256.Size]byte
+}
+
+// markerClass matches the css-class added to components by mark, and those mjml derives from it, such as the class of
+// the tables generated for Outlook
+var markerClass = regexp.MustCompile(`^` + markerPrefix + `(\d+)(-[a-z]+)?$`)
+
+// markerClasses matches the marker classes in conditional comments
+var markerClasses = regexp.MustCompile(` ?\b` + markerPrefix + `\d+(-[a-z]+)?\b`)
+
+// carouselID matches the random ids mjml generates for carousels
+var carouselID = regexp.MustCompile(`mj-carousel-(?:radio-)?[0-9a-f]{16}`)
+
+// cssSpace matches the whitespace around the punctuation of CSS, which is insignificant
+var cssSpace = regexp.MustCompile(`\s*([{}:;,>])\s*`)
+
+// normalizer converts html nodes to their canonical form. components are the paths of the components identified by
+// marker classes.
+type normalizer struct {
+	components []string
+}
+
+// parse parses an html document into its canonical form
+func parse(document string, components []string) (*node, error) {
+	doc, err := html.Parse(strings.NewReader(replaceCarouselIDs(document)))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing html: %w", err)
+	}
+
+	n := normalizer{components: components}
+
+	root := &node{kind: elementNode}
+	root.children = n.children(doc, root)
+	root.hash = hash(root)
+
+	setPaths(root)
+
+	return root, nil
+}
+
+// children returns the canonical form of the children of an html node
+func (n normalizer) children(h *html.Node, parent *node) []*node {
+	var children []*node
+
+	for child := h.FirstChild; child != nil; child = child.NextSibling {
+		switch child.Type {
+		case html.ElementNode:
+			children = append(children, n.element(child, parent))
+
+		case html.TextNode:
+			if parent.tag == "style" {
+				for _, rule := range cssRules(child.Data) {
+					children = append(children, &node{kind: textNode, text: rule, component: parent.component})
+				}
+
+				continue
+			}
+
+			if text := collapse(child.Data); text != "" {
+				children = append(children, &node{kind: textNode, text: html.EscapeString(text), component: parent.component})
+			}
+
+		case html.CommentNode:
+			children = append(children, n.comment(child.Data, parent))
+
+		case html.DoctypeNode:
+			children = append(children, &node{kind: doctypeNode, text: "<!doctype " + strings.ToLower(child.Data) + ">"})
+		}
+	}
+
+	for _, child := range children {
+		child.hash = hash(child)
+	}
+
+	return children
+}
+
+func (n normalizer) element(h *html.Node, parent *node) *node {
+	e := &node{kind: elementNode, tag: h.Data, component: parent.component}
+
+	var component string
+
+	e.attributes, component = n.attributes(h.Attr)
+
+	if component != "" {
+		e.component = component
+	}
+
+	e.children = n.children(h, e)
+
+	return e
+}
+
+// attributes returns the sorted and normalized attributes, and the component identified by a marker class
+func (n normalizer) attributes(attributes []html.Attribute) ([]html.Attribute, string) {
+	var (
+		normalized []html.Attribute
+		component  string
+	)
+
+	for _, a := range attributes {
+		value := a.Val
+
+		switch a.Key {
+		case "class":
+			var classes []string
+
+			for _, class := range strings.Fields(value) {
+				if match := markerClass.FindStringSubmatch(class); match != nil {
+					if i, err := strconv.Atoi(match[1]); err == nil && i < len(n.components) && component == "" {
+						component = n.components[i]
+					}
+
+					continue
+				}
+
+				if !slices.Contains(classes, class) {
+					classes = append(classes, class)
+				}
+			}
+
+			slices.Sort(classes)
+			value = strings.Join(classes, " ")
+
+		case "style":
+			value = formatStyle(parseStyle(value))
+		}
+
+		if value == "" && (a.Key == "class" || a.Key == "style") {
+			continue
+		}
+
+		normalized = append(normalized, html.Attribute{Key: a.Key, Val: value})
+	}
+
+	slices.SortStableFunc(normalized, func(a, b html.Attribute) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return normalized, component
+}
+
+// comment returns the canonical form of a comment. The html of conditional comments is tokenized, so the attributes
+// of the tags in it are normalized like those of elements.
+func (n normalizer) comment(data string, parent *node) *node {
+	c := &node{kind: commentNode, component: parent.component}
+
+	end := strings.Index(data, "]>")
+
+	if !strings.HasPrefix(data, "[if") || end < 0 {
+		c.text = "<!--" + collapse(data) + "-->"
+		return c
+	}
+
+	c.text = "<!--" + collapse(data[:end+1]) + ">"
+	content := data[end+2:]
+
+	if content == "<!" {
+		c.text += "<!-->"
+		return c
+	}
+
+	if strings.HasSuffix(content, "<![endif]") {
+		content = strings.TrimSuffix(content, "<![endif]")
+		c.end = "<![endif]-->"
+	}
+
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tokenType := z.Next()
+
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		token := z.Token()
+
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			attributes, component := n.attributes(token.Attr)
+
+			if component != "" && c.component == parent.component {
+				c.component = component
+			}
+
+			c.tokens = append(c.tokens, startTag(token.Data, attributes))
+
+		case html.EndTagToken:
+			c.tokens = append(c.tokens, "</"+token.Data+">")
+
+		case html.TextToken:
+			if text := collapse(token.Data); text != "" {
+				c.tokens = append(c.tokens, html.EscapeString(text))
+			}
+
+		case html.CommentToken:
+			c.tokens = append(c.tokens, "<!--"+collapse(markerClasses.ReplaceAllString(token.Data, ""))+"-->")
+		}
+	}
+
+	return c
+}
+
+// setPaths sets the paths of the descendants of n. The path of an element is its tag, indexed if its parent has
+// several children with the same tag, and nodes that are not elements have the path of their parent.
+func setPaths(n *node) {
+	counts := map[string]int{}
+
+	for _, child := range n.children {
+		if child.kind == elementNode {
+			counts[child.tag]++
+		}
+	}
+
+	indexes := map[string]int{}
+
+	for _, child := range n.children {
+		if child.kind != elementNode {
+			child.path = n.path
+			continue
+		}
+
+		indexes[child.tag]++
+
+		child.path = child.tag
+
+		if counts[child.tag] > 1 {
+			child.path += fmt.Sprintf("[%d]", indexes[child.tag])
+		}
+
+		if n.path != "" {
+			child.path = n.path + " > " + child.path
+		}
+
+		setPaths(child)
+	}
+}
+
+// pairable reports whether nodes can be paired as a modified node, which requires elements to have the same tag and,
+// if both are attributed to components, the same kind of component
+func pairable(a *node, b *node) bool {
+	if a.kind != b.kind || a.tag != b.tag {
+		return false
+	}
+
+	switch a.kind {
+	case elementNode:
+		return a.component == "" || b.component == "" || componentTag(a.component) == componentTag(b.component)
+
+	case commentNode:
+		return a.text == b.text
+	}
+
+	return true
+}
+
+// innerComponent returns the first component of the descendants of n that differs from component, or component if
+// there is none
+func (n *node) innerComponent(component string) string {
+	if n.component != component && n.component != "" {
+		return n.component
+	}
+
+	for _, child := range n.children {
+		if inner := child.innerComponent(component); inner != component {
+			return inner
+		}
+	}
+
+	return component
+}
+
+func (n *node) attribute(name string) (string, bool) {
+	for _, a := range n.attributes {
+		if a.Key == name {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+// line returns the first line of the canonical form of a node
+func (n *node) line() string {
+	if n.kind == elementNode {
+		return startTag(n.tag, n.attributes)
+	}
+
+	return n.text
+}
+
+// lines appends the lines of the canonical form of a node and its descendants, indented by depth
+func (n *node) lines(lines []string, depth int) []string {
+	indent := strings.Repeat("  ", depth)
+
+	if n.tag != "" || n.kind != elementNode {
+		lines = append(lines, indent+n.line())
+	}
+
+	childDepth := depth + 1
+
+	if n.tag == "" && n.kind == elementNode {
+		childDepth = depth
+	}
+
+	for _, token := range n.tokens {
+		lines = append(lines, indent+"  "+token)
+	}
+
+	if n.end != "" {
+		lines = append(lines, indent+n.end)
+	}
+
+	for _, child := range n.children {
+		lines = child.lines(lines, childDepth)
+	}
+
+	if n.kind == elementNode && n.tag != "" && !voidElement(n.tag) {
+		lines = append(lines, indent+"</"+n.tag+">")
+	}
+
+	return lines
+}
+
+// hash returns the hash of the canonical form of a node and its descendants, whose children are already hashed
+func hash(n *node) [sha256.Size]byte {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%d\x00%s\x00%s\x00", n.kind, n.line(), n.end)
+
+	for _, token := range n.tokens {
+		fmt.Fprintf(h, "%s\x00", token)
+	}
+
+	for _, child := range n.children {
+		h.Write(child.hash[:])
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+
+	return sum
+}
+
+func startTag(tag string, attributes []html.Attribute) string {
+	var sb strings.Builder
+
+	sb.WriteString("<" + tag)
+
+	for _, a := range attributes {
+		fmt.Fprintf(&sb, ` %s="%s"`, a.Key, html.EscapeString(a.Val))
+	}
+
+	sb.WriteString(">")
+
+	return sb.String()
+}
+
+func voidElement(tag string) bool {
+	switch atom.Lookup([]byte(tag)) {
+	case atom.Area, atom.Base, atom.Br, atom.Col, atom.Embed, atom.Hr, atom.Img, atom.Input, atom.Link, atom.Meta,
+		atom.Source, atom.Track, atom.Wbr:
+		return true
+	}
+
+	return false
+}
+
+// declaration is a property of a style attribute
+type declaration struct {
+	property string
+	value    string
+}
+
+// parseStyle parses the declarations of a style attribute, sorted by property. Semicolons in parentheses and quotes,
+// such as those of data URLs, do not separate declarations.
+func parseStyle(style string) []declaration {
+	var (
+		declarations []declaration
+		depth        int
+		quote        rune
+		start        int
+	)
+
+	add := func(s string) {
+		property, value, ok := strings.Cut(s, ":")
+
+		if property = strings.ToLower(strings.TrimSpace(property)); ok && property != "" {
+			declarations = append(declarations, declaration{property: property, value: collapse(value)})
+		}
+	}
+
+	for i, r := range style {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+
+		case r == '"' || r == '\'':
+			quote = r
+
+		case r == '(':
+			depth++
+
+		case r == ')' && depth > 0:
+			depth--
+
+		case r == ';' && depth == 0:
+			add(style[start:i])
+			start = i + 1
+		}
+	}
+
+	add(style[start:])
+
+	slices.SortStableFunc(declarations, func(a, b declaration) int {
+		return strings.Compare(a.property, b.property)
+	})
+
+	return declarations
+}
+
+func formatStyle(declarations []declaration) string {
+	parts := make([]string, len(declarations))
+
+	for i, d := range declarations {
+		parts[i] = d.property + ":" + d.value
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// properties returns the values of the properties of a style attribute. The last declaration of a property wins.
+func properties(style string) map[string]string {
+	values := map[string]string{}
+
+	for _, d := range parseStyle(style) {
+		values[d.property] = d.value
+	}
+
+	return values
+}
+
+// cssRules returns the rules of a style sheet with insignificant whitespace removed, one per line
+func cssRules(css string) []string {
+	css = cssSpace.ReplaceAllString(collapse(css), "$1")
+	css = strings.ReplaceAll(css, ";}", "}")
+
+	var rules []string
+
+	for _, rule := range strings.SplitAfter(css, "}") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// replaceCarouselIDs replaces the random ids of carousels by their index, so that compiling the same carousel twice
+// generates the same html
+func replaceCarouselIDs(document string) string {
+	ids := map[string]string{}
+
+	return carouselID.ReplaceAllStringFunc(document, func(match string) string {
+		prefix, id := match[:len(match)-16], match[len(match)-16:]
+
+		if _, ok := ids[id]; !ok {
+			ids[id] = strconv.Itoa(len(ids) + 1)
+		}
+
+		return prefix + ids[id]
+	})
+}
+
+// collapse collapses the html whitespace in s, which does not include non-breaking spaces
+func collapse(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f'
+	}), " ")
+}
+
+// componentTag returns the tag of the innermost component of a component path
+func componentTag(component string) string {
+	component = component[strings.LastIndex(component, " > ")+1:]
+	tag, _, _ := strings.Cut(strings.TrimSpace(component), "[")
+	return tag
+}
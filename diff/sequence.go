@@ -0,0 +1,123 @@
+package diff
+
+import "slices"
+
+// op is an operation transforming a sequence into another
+type op int
+
+const (
+	keep op = iota
+	insert
+	remove
+)
+
+// sequence returns the operations transforming a sequence of length n into one of length m, where equal reports
+// whether the elements at i in the first sequence and j in the second are equal. It uses the algorithm of Myers
+// after trimming the common prefix and suffix, which are usually most of the sequences.
+func sequence(n int, m int, equal func(i, j int) bool) []op {
+	prefix := 0
+
+	for prefix < n && prefix < m && equal(prefix, prefix) {
+		prefix++
+	}
+
+	suffix := 0
+
+	for suffix < n-prefix && suffix < m-prefix && equal(n-1-suffix, m-1-suffix) {
+		suffix++
+	}
+
+	ops := make([]op, prefix, n+m)
+
+	ops = append(ops, myers(n-prefix-suffix, m-prefix-suffix, func(i, j int) bool {
+		return equal(prefix+i, prefix+j)
+	})...)
+
+	for range suffix {
+		ops = append(ops, keep)
+	}
+
+	return ops
+}
+
+// myers returns the shortest sequence of operations transforming a sequence of length n into one of length m,
+// preferring removals before insertions
+func myers(n int, m int, equal func(i, j int) bool) []op {
+	total := n + m
+	offset := total + 1
+	v := make([]int, 2*total+3)
+
+	var trace [][]int
+
+	for d := 0; d <= total; d++ {
+		trace = append(trace, slices.Clone(v))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+
+			for x < n && y < m && equal(x, y) {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, offset, n, m)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrack follows the trace of myers back from the end of the sequences to find the operations
+func backtrack(trace [][]int, offset int, x int, y int) []op {
+	var ops []op
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		previous := k - 1
+
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			previous = k + 1
+		}
+
+		previousX := v[offset+previous]
+		previousY := previousX - previous
+
+		for x > previousX && y > previousY {
+			ops = append(ops, keep)
+			x--
+			y--
+		}
+
+		if x == previousX {
+			ops = append(ops, insert)
+		} else {
+			ops = append(ops, remove)
+		}
+
+		x, y = previousX, previousY
+	}
+
+	for x > 0 && y > 0 {
+		ops = append(ops, keep)
+		x--
+		y--
+	}
+
+	slices.Reverse(ops)
+
+	return ops
+}
@@ -3,15 +3,24 @@ package mjml
 import (
 	"fmt"
 	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+	"github.com/Boostport/mjml-go/internal/errorlines"
 )
 
 type Error struct {
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details"`
+
+	// HTML is the output compiled despite the errors, which is only available when the validation level is soft
+	HTML string `json:"-"`
+}
+
+// ErrorDetail describes a problem with an element of the mjml source
+type ErrorDetail struct {
+	Line    int    `json:"line"`
 	Message string `json:"message"`
-	Details []struct {
-		Line    int    `json:"line"`
-		Message string `json:"message"`
-		TagName string `json:"tagName"`
-	} `json:"details"`
+	TagName string `json:"tagName"`
 }
 
 func (e Error) Error() string {
@@ -36,3 +45,32 @@ func (e Error) Error() string {
 
 	return sb.String()
 }
+
+func (e *Error) addDetail(node *ast.Node, message string) {
+	e.Details = append(e.Details, ErrorDetail{
+		Line:    node.Line,
+		Message: message,
+		TagName: node.Tag,
+	})
+}
+
+// mapLines translates the lines reported for the compiled mjml back to the lines of the original source
+func (e *Error) mapLines(lineMap ast.LineMap) {
+	if lineMap == nil {
+		return
+	}
+
+	for i, detail := range e.Details {
+		if line, ok := lineMap[detail.Line]; ok {
+			e.Details[i].Line = line
+		}
+	}
+
+	e.Message = errorlines.Replace(e.Message, func(line int) int {
+		if mapped, ok := lineMap[line]; ok {
+			return mapped
+		}
+
+		return line
+	})
+}
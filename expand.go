@@ -0,0 +1,131 @@
+package mjml
+
+import (
+	"fmt"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// maxExpansionDepth limits how many times components can expand into other components
+const maxExpansionDepth = 100
+
+// Component is a custom component implemented in Go. Its tag is expanded into standard MJML before the template is
+// compiled.
+type Component struct {
+	// Tag is the tag of the component, for example mj-product
+	Tag string
+
+	// Required lists the attributes that must be set on the component
+	Required []string
+
+	// Expand returns the MJML nodes the component expands to given its attributes and children, which replace its tag
+	// as siblings. Returning no nodes removes it. The returned MJML may contain other components.
+	Expand func(attrs map[string]string, children []*ast.Node) ([]*ast.Node, error)
+}
+
+// WithComponents expands the components in the template before it is compiled. Errors in the expanded MJML are
+// reported on the line of the component's tag.
+func WithComponents(components ...Component) ToHTMLOption {
+	return func(o options) {
+		if o.local.components == nil {
+			o.local.components = map[string]Component{}
+		}
+
+		for _, component := range components {
+			o.local.components[component.Tag] = component
+		}
+	}
+}
+
+func expandComponents(root *ast.Node, components map[string]Component) error {
+	if len(components) == 0 {
+		return nil
+	}
+
+	mjmlError := Error{Message: "Component expansion error"}
+
+	expandChildren(root, components, 0, &mjmlError)
+
+	if len(mjmlError.Details) > 0 {
+		return mjmlError
+	}
+
+	return nil
+}
+
+// expandChildren expands the components among the descendants of parent. The number of expansions that produced
+// parent is tracked to stop components that expand into themselves.
+func expandChildren(parent *ast.Node, components map[string]Component, expansions int, mjmlError *Error) {
+	children := make([]*ast.Node, 0, len(parent.Children))
+
+	for _, child := range parent.Children {
+		children = append(children, expandNode(child, components, expansions, mjmlError)...)
+	}
+
+	parent.Children = children
+}
+
+// expandNode returns the nodes that replace node once it and its descendants are expanded
+func expandNode(node *ast.Node, components map[string]Component, expansions int, mjmlError *Error) []*ast.Node {
+	component, ok := components[node.Tag]
+
+	if node.Type != ast.ElementNode || !ok {
+		expandChildren(node, components, expansions, mjmlError)
+		return []*ast.Node{node}
+	}
+
+	if expansions >= maxExpansionDepth {
+		mjmlError.addDetail(node, fmt.Sprintf("Component expands more than %d times", maxExpansionDepth))
+		return nil
+	}
+
+	expanded, err := component.expand(node)
+
+	if err != nil {
+		mjmlError.addDetail(node, err.Error())
+		return nil
+	}
+
+	var nodes []*ast.Node
+
+	for _, n := range expanded {
+		if n == nil {
+			continue
+		}
+
+		setMissingLines(n, node.Line)
+		nodes = append(nodes, expandNode(n, components, expansions+1, mjmlError)...)
+	}
+
+	return nodes
+}
+
+func (c Component) expand(node *ast.Node) ([]*ast.Node, error) {
+	attrs := make(map[string]string, len(node.Attributes))
+
+	for _, attr := range node.Attributes {
+		attrs[attr.Name] = attr.Value
+	}
+
+	for _, name := range c.Required {
+		if _, ok := attrs[name]; !ok {
+			return nil, fmt.Errorf("Attribute %s is required", name)
+		}
+	}
+
+	if c.Expand == nil {
+		return nil, fmt.Errorf("Component %s has no Expand function", c.Tag)
+	}
+
+	return c.Expand(attrs, node.Children)
+}
+
+// setMissingLines sets the line of nodes generated by a component to the line of the component's tag
+func setMissingLines(node *ast.Node, line int) {
+	node.Walk(func(n *ast.Node) bool {
+		if n.Line == 0 {
+			n.Line = line
+		}
+		return true
+	})
+}
@@ -0,0 +1,159 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+var productComponent = Component{
+	Tag:      "mj-product",
+	Required: []string{"name", "price"},
+	Expand: func(attrs map[string]string, children []*ast.Node) ([]*ast.Node, error) {
+		text := ast.NewElement("mj-text")
+		text.Content = attrs["name"] + ": " + attrs["price"]
+
+		if color, ok := attrs["color"]; ok {
+			text.SetAttr("color", color)
+		}
+
+		column := ast.NewElement("mj-column")
+		column.AppendChild(text)
+		column.AppendChild(children...)
+
+		return []*ast.Node{column}, nil
+	},
+}
+
+func TestExpandComponents(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-product name="Shoes" price="$10" color="#ff0000">
+        <mj-button href="https://example.com">Buy</mj-button>
+      </mj-product>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	output, err := ToHTML(context.Background(), input, WithComponents(productComponent))
+
+	if err != nil {
+		t.Fatalf("Error converting mjml with components to html: %s", err)
+	}
+
+	for _, expected := range []string{"Shoes: $10", "color:#ff0000", "https://example.com"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q", expected)
+		}
+	}
+}
+
+func TestExpandComponentsSiblings(t *testing.T) {
+
+	products := Component{
+		Tag: "mj-products",
+		Expand: func(attrs map[string]string, children []*ast.Node) ([]*ast.Node, error) {
+			var nodes []*ast.Node
+
+			for _, name := range strings.Split(attrs["names"], ",") {
+				product := ast.NewElement("mj-product")
+				product.SetAttr("name", name)
+				product.SetAttr("price", "$10")
+				nodes = append(nodes, product)
+			}
+
+			return nodes, nil
+		},
+	}
+
+	root, err := ast.Parse(`<mjml><mj-body><mj-section><mj-products names="Shoes,Socks" /><mj-column /></mj-section></mj-body></mjml>`)
+
+	if err != nil {
+		t.Fatalf("Error parsing mjml: %s", err)
+	}
+
+	if err := expandComponents(root, map[string]Component{products.Tag: products, productComponent.Tag: productComponent}); err != nil {
+		t.Fatalf("Error expanding components: %s", err)
+	}
+
+	section := root.Child("mj-body").Child("mj-section")
+
+	if len(section.Children) != 3 {
+		t.Fatalf("Expected the component to expand into 2 columns followed by the existing one, got %d children", len(section.Children))
+	}
+
+	for i, name := range []string{"Shoes", "Socks"} {
+		if text := section.Children[i].Child("mj-text"); section.Children[i].Tag != "mj-column" || text == nil || text.Content != name+": $10" {
+			t.Errorf("Expected column %d to contain the product %s", i, name)
+		}
+	}
+}
+
+func TestExpandComponentsErrors(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-product name="Shoes" price="$10" color="red-ish">
+      </mj-product>
+      <mj-product name="Socks">
+      </mj-product>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	_, err := ToHTML(context.Background(), input, WithComponents(productComponent))
+
+	var mjmlError Error
+
+	if !errors.As(err, &mjmlError) {
+		t.Fatalf("Expected an mjml error, got: %v", err)
+	}
+
+	if len(mjmlError.Details) != 1 || mjmlError.Details[0].Line != 6 || mjmlError.Details[0].TagName != "mj-product" {
+		t.Errorf("Expected a missing attribute error for the component on line 6: %s", mjmlError)
+	}
+
+	input = strings.Replace(input, `<mj-product name="Socks">`, `<mj-product name="Socks" price="$5">`, 1)
+
+	_, err = ToHTML(context.Background(), input, WithComponents(productComponent), WithValidationLevel(Soft))
+
+	if !errors.As(err, &mjmlError) {
+		t.Fatalf("Expected an mjml error, got: %v", err)
+	}
+
+	if len(mjmlError.Details) != 1 || mjmlError.Details[0].Line != 4 || mjmlError.Details[0].TagName != "mj-text" {
+		t.Errorf("Expected the invalid color in the expanded MJML to be reported on line 4: %s", mjmlError)
+	}
+}
+
+func TestExpandComponentsRecursion(t *testing.T) {
+
+	recursive := Component{
+		Tag: "mj-recursive",
+		Expand: func(attrs map[string]string, children []*ast.Node) ([]*ast.Node, error) {
+			wrapper := ast.NewElement("mj-wrapper")
+			wrapper.AppendChild(ast.NewElement("mj-recursive"))
+			return []*ast.Node{wrapper}, nil
+		},
+	}
+
+	root, err := ast.Parse("<mjml><mj-body><mj-recursive /></mj-body></mjml>")
+
+	if err != nil {
+		t.Fatalf("Error parsing mjml: %s", err)
+	}
+
+	err = expandComponents(root, map[string]Component{recursive.Tag: recursive})
+
+	var mjmlError Error
+
+	if !errors.As(err, &mjmlError) || len(mjmlError.Details) != 1 {
+		t.Errorf("Expected an error for a component that expands into itself, got: %v", err)
+	}
+}
@@ -6,6 +6,8 @@ require (
 	github.com/andybalholm/brotli v1.2.0
 	github.com/jackc/puddle/v2 v2.2.2
 	github.com/tetratelabs/wazero v1.9.0
+	golang.org/x/net v0.34.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
-require golang.org/x/sync v0.3.0 // indirect
+require golang.org/x/sync v0.10.0 // indirect
@@ -0,0 +1,21 @@
+module github.com/Boostport/mjml-go/grpcserver
+
+go 1.22.0
+
+require (
+	github.com/Boostport/mjml-go v0.16.0
+	google.golang.org/grpc v1.71.3
+	google.golang.org/protobuf v1.36.6
+)
+
+require (
+	github.com/andybalholm/brotli v1.2.0 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
+	github.com/stretchr/testify v1.10.0 // indirect
+	github.com/tetratelabs/wazero v1.9.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250115164207-1a7da9e5054f // indirect
+)
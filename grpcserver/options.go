@@ -0,0 +1,307 @@
+package grpcserver
+
+import (
+	"fmt"
+
+	"github.com/Boostport/mjml-go"
+	mjmlv1 "github.com/Boostport/mjml-go/grpcserver/proto/mjml/v1"
+)
+
+var validationLevels = map[mjmlv1.ValidationLevel]mjml.ValidationLevel{
+	mjmlv1.ValidationLevel_VALIDATION_LEVEL_STRICT: mjml.Strict,
+	mjmlv1.ValidationLevel_VALIDATION_LEVEL_SOFT:   mjml.Soft,
+	mjmlv1.ValidationLevel_VALIDATION_LEVEL_SKIP:   mjml.Skip,
+}
+
+var quoteCharacters = map[mjmlv1.HTMLMinifierQuoteCharacter]mjml.HTMLMinifierQuoteCharacter{
+	mjmlv1.HTMLMinifierQuoteCharacter_HTML_MINIFIER_QUOTE_CHARACTER_SINGLE: mjml.HTMLMinifierSingleQuote,
+	mjmlv1.HTMLMinifierQuoteCharacter_HTML_MINIFIER_QUOTE_CHARACTER_DOUBLE: mjml.HTMLMinifierDoubleQuote,
+}
+
+var braceStyles = map[mjmlv1.BeautifyBraceStyle]mjml.BeautifyBraceStyle{
+	mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_COLLAPSE_PRESERVE_INLINE: mjml.BeautifyBraceStyleCollapsePreserveInline,
+	mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_COLLAPSE:                 mjml.BeautifyBraceStyleCollapse,
+	mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_EXPAND:                   mjml.BeautifyBraceStyleExpand,
+	mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_END_EXPAND:               mjml.BeautifyBraceStyleEndExpand,
+	mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_NONE:                     mjml.BeautifyBraceStyleNone,
+}
+
+var indentScripts = map[mjmlv1.BeautifyIndentScripts]mjml.BeautifyIndentScripts{
+	mjmlv1.BeautifyIndentScripts_BEAUTIFY_INDENT_SCRIPTS_KEEP:     mjml.BeautifyIndentScriptsKeep,
+	mjmlv1.BeautifyIndentScripts_BEAUTIFY_INDENT_SCRIPTS_SEPARATE: mjml.BeautifyIndentScriptsSeparate,
+	mjmlv1.BeautifyIndentScripts_BEAUTIFY_INDENT_SCRIPTS_NORMAL:   mjml.BeautifyIndentScriptsNormal,
+}
+
+var wrapAttributes = map[mjmlv1.BeautifyWrapAttributes]mjml.BeautifyWrapAttributes{
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_AUTO:                   mjml.BeautifyWrapAttributesAuto,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_FORCE:                  mjml.BeautifyWrapAttributesForce,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_FORCE_ALIGNED:          mjml.BeautifyWrapAttributesForceAligned,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_FORCE_EXPAND_MULTILINE: mjml.BeautifyWrapAttributesForceExpandMultiline,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_ALIGNED_MULTIPLE:       mjml.BeautifyWrapAttributesAlignedMultiple,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_PRESERVE:               mjml.BeautifyWrapAttributesPreserve,
+	mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_PRESERVE_ALIGNED:       mjml.BeautifyWrapAttributesPreserveAligned,
+}
+
+var templating = map[mjmlv1.BeautifyTemplating]mjml.BeautifyTemplating{
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_AUTO:       mjml.BeautifyTemplatingAuto,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_NONE:       mjml.BeautifyTemplatingNone,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_DJANGO:     mjml.BeautifyTemplatingDjango,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_ERB:        mjml.BeautifyTemplatingERB,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_HANDLEBARS: mjml.BeautifyTemplatingHandlebars,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_PHP:        mjml.BeautifyTemplatingPHP,
+	mjmlv1.BeautifyTemplating_BEAUTIFY_TEMPLATING_SMARTY:     mjml.BeautifyTemplatingSmarty,
+}
+
+// toHTMLOptions converts the options of a request into options for the library
+func toHTMLOptions(o *mjmlv1.Options) ([]mjml.ToHTMLOption, error) {
+	if o == nil {
+		return nil, nil
+	}
+
+	var options []mjml.ToHTMLOption
+
+	if o.Beautify != nil {
+		options = append(options, mjml.WithBeautify(*o.Beautify))
+	}
+
+	if o.BeautifyOptions != nil {
+		beautifyOptions, err := toBeautifyOptions(o.BeautifyOptions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, mjml.WithBeautifyOptions(beautifyOptions))
+	}
+
+	if len(o.Fonts) > 0 {
+		options = append(options, mjml.WithFonts(o.Fonts))
+	}
+
+	if o.JuiceOptions != nil {
+		options = append(options, mjml.WithJuiceOptions(toJuiceOptions(o.JuiceOptions)))
+	}
+
+	if len(o.JuicePreserveTags) > 0 {
+		tags := make(map[string]mjml.JuiceTag, len(o.JuicePreserveTags))
+
+		for name, tag := range o.JuicePreserveTags {
+			tags[name] = mjml.JuiceTag{Start: tag.GetStart(), End: tag.GetEnd()}
+		}
+
+		options = append(options, mjml.WithJuicePreserveTags(tags))
+	}
+
+	if o.KeepComments != nil {
+		options = append(options, mjml.WithKeepComments(*o.KeepComments))
+	}
+
+	if o.Minify != nil {
+		options = append(options, mjml.WithMinify(*o.Minify))
+	}
+
+	if o.MinifyOptions != nil {
+		minifyOptions, err := toHTMLMinifierOptions(o.MinifyOptions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, mjml.WithMinifyOptions(minifyOptions))
+	}
+
+	if o.ValidationLevel != mjmlv1.ValidationLevel_VALIDATION_LEVEL_UNSPECIFIED {
+		level, err := enum("validation_level", o.ValidationLevel, validationLevels)
+
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, mjml.WithValidationLevel(level))
+	}
+
+	return options, nil
+}
+
+func toJuiceOptions(o *mjmlv1.JuiceOptions) mjml.JuiceOptions {
+	j := mjml.NewJuiceOptions()
+
+	j = set(j, o.ApplyAttributesTableElements, mjml.JuiceOptions.ApplyAttributesTableElements)
+	j = set(j, o.ApplyHeightAttributes, mjml.JuiceOptions.ApplyHeightAttributes)
+	j = set(j, o.ApplyStyleTags, mjml.JuiceOptions.ApplyStyleTags)
+	j = set(j, o.ApplyWidthAttributes, mjml.JuiceOptions.ApplyWidthAttributes)
+	j = set(j, o.ExtraCss, mjml.JuiceOptions.ExtraCss)
+	j = set(j, o.InsertPreservedExtraCss, mjml.JuiceOptions.InsertPreservedExtraCss)
+	j = set(j, o.InlinePseudoElements, mjml.JuiceOptions.InlinePseudoElements)
+	j = set(j, o.PreserveFontFaces, mjml.JuiceOptions.PreserveFontFaces)
+	j = set(j, o.PreserveImportant, mjml.JuiceOptions.PreserveImportant)
+	j = set(j, o.PreserveMediaQueries, mjml.JuiceOptions.PreserveMediaQueries)
+	j = set(j, o.PreserveKeyFrames, mjml.JuiceOptions.PreserveKeyFrames)
+	j = set(j, o.PreservePseudos, mjml.JuiceOptions.PreservePseudos)
+	j = set(j, o.RemoveStyleTags, mjml.JuiceOptions.RemoveStyleTags)
+	j = set(j, o.XmlMode, mjml.JuiceOptions.XmlMode)
+
+	return j
+}
+
+func toHTMLMinifierOptions(o *mjmlv1.HTMLMinifierOptions) (mjml.HTMLMinifierOptions, error) {
+	m := mjml.NewHTMLMinifierOptions()
+
+	m = set(m, o.CaseSensitive, mjml.HTMLMinifierOptions.CaseSensitive)
+	m = set(m, o.CollapseBooleanAttributes, mjml.HTMLMinifierOptions.CollapseBooleanAttributes)
+	m = set(m, o.CollapseInlineTagWhitespace, mjml.HTMLMinifierOptions.CollapseInlineTagWhitespace)
+	m = set(m, o.CollapseWhitespace, mjml.HTMLMinifierOptions.CollapseWhitespace)
+	m = set(m, o.ConservativeCollapse, mjml.HTMLMinifierOptions.ConservativeCollapse)
+	m = set(m, o.ContinueOnParseError, mjml.HTMLMinifierOptions.ContinueOnParseError)
+	m = setSlice(m, o.CustomAttrAssign, mjml.HTMLMinifierOptions.CustomAttrAssign)
+	m = set(m, o.CustomAttrCollapse, mjml.HTMLMinifierOptions.CustomAttrCollapse)
+	m = setSlice(m, o.CustomAttrSurround, mjml.HTMLMinifierOptions.CustomAttrSurround)
+	m = set(m, o.DecodeEntities, mjml.HTMLMinifierOptions.DecodeEntities)
+	m = set(m, o.Html5, mjml.HTMLMinifierOptions.HTML5)
+	m = setSlice(m, o.IgnoreCustomComments, mjml.HTMLMinifierOptions.IgnoreCustomComments)
+	m = setSlice(m, o.IgnoreCustomFragments, mjml.HTMLMinifierOptions.IgnoreCustomFragments)
+	m = set(m, o.IncludeAutoGeneratedTags, mjml.HTMLMinifierOptions.IncludeAutoGeneratedTags)
+	m = set(m, o.KeepClosingSlash, mjml.HTMLMinifierOptions.KeepClosingSlash)
+	m = setUint(m, o.MaxLineLength, mjml.HTMLMinifierOptions.MaxLineLength)
+	m = set(m, o.MinifyCss, mjml.HTMLMinifierOptions.MinifyCSS)
+	m = set(m, o.MinifyUrls, mjml.HTMLMinifierOptions.MinifyURLs)
+	m = set(m, o.PreserveLineBreaks, mjml.HTMLMinifierOptions.PreserveLineBreaks)
+	m = set(m, o.PreventAttributesEscaping, mjml.HTMLMinifierOptions.PreventAttributesEscaping)
+	m = set(m, o.ProcessConditionalComments, mjml.HTMLMinifierOptions.ProcessConditionalComments)
+	m = setSlice(m, o.ProcessScripts, mjml.HTMLMinifierOptions.ProcessScripts)
+	m = set(m, o.RemoveAttributeQuotes, mjml.HTMLMinifierOptions.RemoveAttributeQuotes)
+	m = set(m, o.RemoveComments, mjml.HTMLMinifierOptions.RemoveComments)
+	m = set(m, o.RemoveEmptyAttributes, mjml.HTMLMinifierOptions.RemoveEmptyAttributes)
+	m = set(m, o.RemoveEmptyElements, mjml.HTMLMinifierOptions.RemoveEmptyElements)
+	m = set(m, o.RemoveOptionalTags, mjml.HTMLMinifierOptions.RemoveOptionalTags)
+	m = set(m, o.RemoveRedundantAttributes, mjml.HTMLMinifierOptions.RemoveRedundantAttributes)
+	m = set(m, o.RemoveScriptTypeAttributes, mjml.HTMLMinifierOptions.RemoveScriptTypeAttributes)
+	m = set(m, o.RemoveStyleLinkTypeAttributes, mjml.HTMLMinifierOptions.RemoveStyleLinkTypeAttributes)
+	m = set(m, o.RemoveTagWhitespace, mjml.HTMLMinifierOptions.RemoveTagWhitespace)
+	m = set(m, o.SortAttributes, mjml.HTMLMinifierOptions.SortAttributes)
+	m = set(m, o.SortClassName, mjml.HTMLMinifierOptions.SortClassName)
+	m = set(m, o.TrimCustomFragments, mjml.HTMLMinifierOptions.TrimCustomFragments)
+	m = set(m, o.UseShortDoctype, mjml.HTMLMinifierOptions.UseShortDoctype)
+
+	if o.QuoteCharacter != mjmlv1.HTMLMinifierQuoteCharacter_HTML_MINIFIER_QUOTE_CHARACTER_UNSPECIFIED {
+		quoteCharacter, err := enum("minify_options.quote_character", o.QuoteCharacter, quoteCharacters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m = m.QuoteCharacter(quoteCharacter)
+	}
+
+	return m, nil
+}
+
+func toBeautifyOptions(o *mjmlv1.BeautifyOptions) (mjml.BeautifyOptions, error) {
+	b := mjml.NewBeautifyOptions()
+
+	b = setUint(b, o.IndentSize, mjml.BeautifyOptions.IndentSize)
+	b = set(b, o.IndentChar, mjml.BeautifyOptions.IndentChar)
+	b = set(b, o.IndentWithTabs, mjml.BeautifyOptions.IndentWithTabs)
+	b = set(b, o.Eol, mjml.BeautifyOptions.Eol)
+	b = set(b, o.EndWithNewline, mjml.BeautifyOptions.EndWithNewline)
+	b = set(b, o.PreserveNewlines, mjml.BeautifyOptions.PreserveNewlines)
+	b = setUint(b, o.MaxPreserveNewlines, mjml.BeautifyOptions.MaxPreserveNewlines)
+	b = set(b, o.IndentInnerHtml, mjml.BeautifyOptions.IndentInnerHtml)
+	b = setUint(b, o.WrapLineLength, mjml.BeautifyOptions.WrapLineLength)
+	b = setUint(b, o.WrapAttributesIndentSize, mjml.BeautifyOptions.WrapAttributesIndentSize)
+	b = setSlice(b, o.Inline, mjml.BeautifyOptions.Inline)
+	b = setSlice(b, o.Unformatted, mjml.BeautifyOptions.Unformatted)
+	b = setSlice(b, o.ContentUnformatted, mjml.BeautifyOptions.ContentUnformatted)
+	b = setSlice(b, o.ExtraLiners, mjml.BeautifyOptions.ExtraLiners)
+	b = set(b, o.UnformattedContentDelimiter, mjml.BeautifyOptions.UnformattedContentDelimiter)
+	b = set(b, o.IndentEmptyLines, mjml.BeautifyOptions.IndentEmptyLines)
+
+	if o.BraceStyle != mjmlv1.BeautifyBraceStyle_BEAUTIFY_BRACE_STYLE_UNSPECIFIED {
+		braceStyle, err := enum("beautify_options.brace_style", o.BraceStyle, braceStyles)
+
+		if err != nil {
+			return nil, err
+		}
+
+		b = b.BraceStyle(braceStyle)
+	}
+
+	if o.IndentScripts != mjmlv1.BeautifyIndentScripts_BEAUTIFY_INDENT_SCRIPTS_UNSPECIFIED {
+		scripts, err := enum("beautify_options.indent_scripts", o.IndentScripts, indentScripts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		b = b.IndentScripts(scripts)
+	}
+
+	if o.WrapAttributes != mjmlv1.BeautifyWrapAttributes_BEAUTIFY_WRAP_ATTRIBUTES_UNSPECIFIED {
+		wrap, err := enum("beautify_options.wrap_attributes", o.WrapAttributes, wrapAttributes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		b = b.WrapAttributes(wrap)
+	}
+
+	if len(o.Templating) > 0 {
+		languages := make([]mjml.BeautifyTemplating, 0, len(o.Templating))
+
+		for _, t := range o.Templating {
+			language, err := enum("beautify_options.templating", t, templating)
+
+			if err != nil {
+				return nil, err
+			}
+
+			languages = append(languages, language)
+		}
+
+		b = b.Templating(languages)
+	}
+
+	return b, nil
+}
+
+// set calls setter if value is set
+func set[B any, T any](b B, value *T, setter func(B, T) B) B {
+	if value == nil {
+		return b
+	}
+
+	return setter(b, *value)
+}
+
+func setUint[B any](b B, value *uint32, setter func(B, uint) B) B {
+	if value == nil {
+		return b
+	}
+
+	return setter(b, uint(*value))
+}
+
+func setSlice[B any](b B, values []string, setter func(B, []string) B) B {
+	if len(values) == 0 {
+		return b
+	}
+
+	return setter(b, values)
+}
+
+type protoEnum interface {
+	comparable
+	fmt.Stringer
+}
+
+// enum converts a protobuf enum value into the corresponding value of the library
+func enum[E protoEnum, V any](field string, value E, values map[E]V) (V, error) {
+	v, ok := values[value]
+
+	if !ok {
+		return v, fmt.Errorf("invalid value for %s: %s", field, value)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,5 @@
+// Package mjmlv1 contains the messages and gRPC service generated from mjml.proto. The service is implemented by the
+// grpcserver package.
+package mjmlv1
+
+//go:generate sh -c "cd ../.. && buf generate"
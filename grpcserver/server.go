@@ -0,0 +1,226 @@
+// Package grpcserver implements the MJMLService gRPC service defined in proto/mjml/v1/mjml.proto using the library.
+//
+//	s := grpc.NewServer()
+//	mjmlv1.RegisterMJMLServiceServer(s, grpcserver.New())
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/Boostport/mjml-go"
+	mjmlv1 "github.com/Boostport/mjml-go/grpcserver/proto/mjml/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultBatchConcurrency is the default number of templates of a batch compiled concurrently
+const DefaultBatchConcurrency = 10
+
+// Server implements mjmlv1.MJMLServiceServer
+type Server struct {
+	mjmlv1.UnimplementedMJMLServiceServer
+
+	options          []mjml.ToHTMLOption
+	batchConcurrency int
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithToHTMLOptions sets options applied to every compilation before the options in the request
+func WithToHTMLOptions(options ...mjml.ToHTMLOption) Option {
+	return func(s *Server) {
+		s.options = options
+	}
+}
+
+// WithBatchConcurrency sets the number of templates of a batch compiled concurrently
+func WithBatchConcurrency(concurrency int) Option {
+	return func(s *Server) {
+		s.batchConcurrency = concurrency
+	}
+}
+
+// New returns a Server compiling up to DefaultBatchConcurrency templates of a batch concurrently unless it is changed
+// by options
+func New(options ...Option) *Server {
+	s := &Server{
+		batchConcurrency: DefaultBatchConcurrency,
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+func (s *Server) Compile(ctx context.Context, req *mjmlv1.CompileRequest) (*mjmlv1.CompileResponse, error) {
+	html, compileError, err := s.compile(ctx, req.GetMjml(), req.GetOptions(), false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mjmlv1.CompileResponse{Html: html, Error: compileError}, nil
+}
+
+// Validate validates a template. The skip validation level is validated as soft, as templates would never have errors
+// otherwise.
+func (s *Server) Validate(ctx context.Context, req *mjmlv1.ValidateRequest) (*mjmlv1.ValidateResponse, error) {
+	_, compileError, err := s.compile(ctx, req.GetMjml(), req.GetOptions(), true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mjmlv1.ValidateResponse{Valid: compileError == nil, Error: compileError}, nil
+}
+
+func (s *Server) CompileBatch(stream grpc.BidiStreamingServer[mjmlv1.CompileBatchRequest, mjmlv1.CompileBatchResponse]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sendErr error
+	)
+
+	concurrency := make(chan struct{}, max(s.batchConcurrency, 1))
+
+	send := func(res *mjmlv1.CompileBatchResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if sendErr != nil {
+			return
+		}
+
+		if err := stream.Send(res); err != nil {
+			sendErr = err
+			cancel()
+		}
+	}
+
+	var recvErr error
+
+	for {
+		req, err := stream.Recv()
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				recvErr = err
+			}
+
+			break
+		}
+
+		select {
+		case concurrency <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-concurrency }()
+
+			res := &mjmlv1.CompileBatchResponse{Id: req.GetId()}
+
+			html, compileError, err := s.compile(ctx, req.GetMjml(), req.GetOptions(), false)
+
+			switch {
+			case err != nil && ctx.Err() != nil:
+				return
+
+			case err != nil:
+				res.Error = &mjmlv1.Error{Message: status.Convert(err).Message()}
+
+			default:
+				res.Html = html
+				res.Error = compileError
+			}
+
+			send(res)
+		}()
+	}
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	switch {
+	case sendErr != nil:
+		return sendErr
+
+	case recvErr != nil:
+		return recvErr
+	}
+
+	return stream.Context().Err()
+}
+
+// compile compiles mjml, returning validation errors as an mjmlv1.Error and other errors as gRPC status errors. If
+// validate is true, the skip validation level is replaced by soft.
+func (s *Server) compile(ctx context.Context, input string, o *mjmlv1.Options, validate bool) (string, *mjmlv1.Error, error) {
+	options, err := toHTMLOptions(o)
+
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	options = slices.Concat(s.options, options)
+
+	if validate && mjml.NewOptions(options...).ValidationLevel() == mjml.Skip {
+		options = append(options, mjml.WithValidationLevel(mjml.Soft))
+	}
+
+	html, err := mjml.ToHTML(ctx, input, options...)
+
+	var (
+		mjmlError   mjml.Error
+		optionError mjml.OptionError
+	)
+
+	switch {
+	case err == nil:
+		return html, nil, nil
+
+	case errors.As(err, &mjmlError):
+		return mjmlError.HTML, toError(mjmlError), nil
+
+	case errors.As(err, &optionError):
+		return "", nil, status.Error(codes.InvalidArgument, optionError.Error())
+
+	case ctx.Err() != nil:
+		return "", nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	return "", nil, status.Error(codes.Internal, err.Error())
+}
+
+func toError(e mjml.Error) *mjmlv1.Error {
+	res := &mjmlv1.Error{Message: e.Message}
+
+	for _, detail := range e.Details {
+		res.Details = append(res.Details, &mjmlv1.ErrorDetail{
+			Line:    int32(detail.Line),
+			Message: detail.Message,
+			TagName: detail.TagName,
+		})
+	}
+
+	return res
+}
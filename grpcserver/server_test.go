@@ -0,0 +1,198 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	mjmlv1 "github.com/Boostport/mjml-go/grpcserver/proto/mjml/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const testTemplate = `<mjml><mj-body><mj-section><mj-column><mj-text>Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+const invalidTemplate = `<mjml><mj-body><mj-section><mj-column><mj-text color="not-a-color">Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+func newClient(t *testing.T) mjmlv1.MJMLServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+
+	s := grpc.NewServer()
+	mjmlv1.RegisterMJMLServiceServer(s, New(WithBatchConcurrency(2)))
+
+	go func() {
+		_ = s.Serve(listener)
+	}()
+
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+
+	return mjmlv1.NewMJMLServiceClient(conn)
+}
+
+func TestCompile(t *testing.T) {
+
+	client := newClient(t)
+
+	res, err := client.Compile(context.Background(), &mjmlv1.CompileRequest{
+		Mjml: testTemplate,
+		Options: &mjmlv1.Options{
+			Minify:        proto.Bool(true),
+			MinifyOptions: &mjmlv1.HTMLMinifierOptions{RemoveComments: proto.Bool(true)},
+			Fonts:         map[string]string{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if res.GetError() != nil || !strings.Contains(res.GetHtml(), "Hello World") || !strings.Contains(res.GetHtml(), "</div></body></html>") {
+		t.Errorf("Expected minified html containing the text, got: %+v", res)
+	}
+
+	res, err = client.Compile(context.Background(), &mjmlv1.CompileRequest{
+		Mjml:    invalidTemplate,
+		Options: &mjmlv1.Options{ValidationLevel: mjmlv1.ValidationLevel_VALIDATION_LEVEL_SOFT},
+	})
+
+	if err != nil {
+		t.Fatalf("Error compiling invalid template: %s", err)
+	}
+
+	if len(res.GetError().GetDetails()) != 1 || res.GetError().GetDetails()[0].GetTagName() != "mj-text" || !strings.Contains(res.GetHtml(), "Hello World") {
+		t.Errorf("Expected a validation error along with the html, got: %+v", res)
+	}
+
+	_, err = client.Compile(context.Background(), &mjmlv1.CompileRequest{
+		Mjml:    testTemplate,
+		Options: &mjmlv1.Options{BeautifyOptions: &mjmlv1.BeautifyOptions{WrapAttributes: 42}},
+	})
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected an invalid argument error for an unknown enum value, got: %v", err)
+	}
+
+	_, err = client.Compile(context.Background(), &mjmlv1.CompileRequest{
+		Mjml:    testTemplate,
+		Options: &mjmlv1.Options{MinifyOptions: &mjmlv1.HTMLMinifierOptions{IgnoreCustomFragments: []string{"<#(.*"}}},
+	})
+
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "minifyOptions.ignoreCustomFragments") {
+		t.Errorf("Expected an invalid argument error for an invalid regular expression, got: %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+
+	client := newClient(t)
+
+	for input, valid := range map[string]bool{testTemplate: true, invalidTemplate: false} {
+		res, err := client.Validate(context.Background(), &mjmlv1.ValidateRequest{
+			Mjml:    input,
+			Options: &mjmlv1.Options{ValidationLevel: mjmlv1.ValidationLevel_VALIDATION_LEVEL_SOFT},
+		})
+
+		if err != nil {
+			t.Fatalf("Error validating template: %s", err)
+		}
+
+		if res.GetValid() != valid {
+			t.Errorf("Expected valid to be %t, got: %+v", valid, res)
+		}
+	}
+
+	res, err := client.Validate(context.Background(), &mjmlv1.ValidateRequest{
+		Mjml:    invalidTemplate,
+		Options: &mjmlv1.Options{ValidationLevel: mjmlv1.ValidationLevel_VALIDATION_LEVEL_SKIP},
+	})
+
+	if err != nil {
+		t.Fatalf("Error validating template: %s", err)
+	}
+
+	if res.GetValid() || len(res.GetError().GetDetails()) == 0 {
+		t.Errorf("Expected templates to be validated with the skip validation level, got: %+v", res)
+	}
+}
+
+func TestCompileBatch(t *testing.T) {
+
+	client := newClient(t)
+
+	stream, err := client.CompileBatch(context.Background())
+
+	if err != nil {
+		t.Fatalf("Error starting batch: %s", err)
+	}
+
+	const count = 5
+
+	for i := 0; i < count; i++ {
+		input := strings.Replace(testTemplate, "Hello World", fmt.Sprintf("Hello %d", i), 1)
+
+		if i == count-1 {
+			input = ""
+		}
+
+		if err := stream.Send(&mjmlv1.CompileBatchRequest{Id: fmt.Sprint(i), Mjml: input}); err != nil {
+			t.Fatalf("Error sending request: %s", err)
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("Error closing stream: %s", err)
+	}
+
+	received := map[string]*mjmlv1.CompileBatchResponse{}
+
+	for {
+		res, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("Error receiving response: %s", err)
+		}
+
+		received[res.GetId()] = res
+	}
+
+	if len(received) != count {
+		t.Fatalf("Expected %d responses, got %d", count, len(received))
+	}
+
+	for i := 0; i < count-1; i++ {
+		if res := received[fmt.Sprint(i)]; !strings.Contains(res.GetHtml(), fmt.Sprintf("Hello %d", i)) {
+			t.Errorf("Expected response %d to contain its template, got: %+v", i, res)
+		}
+	}
+
+	if res := received[fmt.Sprint(count-1)]; res.GetError().GetMessage() != "input is missing mjml property" {
+		t.Errorf("Expected an error for the empty template, got: %+v", res)
+	}
+}
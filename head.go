@@ -0,0 +1,131 @@
+package mjml
+
+import (
+	"maps"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// headOptions holds elements to inject into the mj-head of a template
+type headOptions struct {
+	title          *string
+	preview        *string
+	breakpoint     *string
+	styles         []style
+	htmlAttributes []htmlAttributes
+}
+
+type style struct {
+	css    string
+	inline bool
+}
+
+type htmlAttributes struct {
+	selector   string
+	attributes map[string]string
+}
+
+func (h *headOptions) empty() bool {
+	return h.title == nil && h.preview == nil && h.breakpoint == nil && len(h.styles) == 0 && len(h.htmlAttributes) == 0
+}
+
+// WithTitle sets the mj-title of the template, replacing the title declared by the template
+func WithTitle(title string) ToHTMLOption {
+	return func(o options) {
+		o.local.head.title = &title
+	}
+}
+
+// WithPreview sets the mj-preview of the template, replacing the preview declared by the template
+func WithPreview(preview string) ToHTMLOption {
+	return func(o options) {
+		o.local.head.preview = &preview
+	}
+}
+
+// WithBreakpoint sets the mj-breakpoint of the template, replacing the breakpoint declared by the template
+func WithBreakpoint(width string) ToHTMLOption {
+	return func(o options) {
+		o.local.head.breakpoint = &width
+	}
+}
+
+// WithStyle adds an mj-style to the template. The styles are added after the styles declared by the template, so
+// they take precedence over template rules with the same specificity. If inline is true, the styles are inlined.
+func WithStyle(css string, inline bool) ToHTMLOption {
+	return func(o options) {
+		o.local.head.styles = append(o.local.head.styles, style{css: css, inline: inline})
+	}
+}
+
+// WithHTMLAttributes adds attributes to the elements of the compiled HTML matching the CSS selector using
+// mj-html-attributes. The attributes are added after the ones declared by the template, so they take precedence.
+func WithHTMLAttributes(selector string, attributes map[string]string) ToHTMLOption {
+	attributes = maps.Clone(attributes)
+
+	return func(o options) {
+		o.local.head.htmlAttributes = append(o.local.head.htmlAttributes, htmlAttributes{selector: selector, attributes: attributes})
+	}
+}
+
+func applyHead(root *ast.Node, h headOptions) {
+	if h.empty() {
+		return
+	}
+
+	mjHead := head(root)
+
+	replace := func(tag string, node *ast.Node) {
+		for _, existing := range mjHead.FindAll(tag) {
+			mjHead.RemoveChild(existing)
+		}
+
+		mjHead.AppendChild(node)
+	}
+
+	if h.title != nil {
+		replace("mj-title", contentElement("mj-title", *h.title))
+	}
+
+	if h.preview != nil {
+		replace("mj-preview", contentElement("mj-preview", *h.preview))
+	}
+
+	if h.breakpoint != nil {
+		replace("mj-breakpoint", ast.NewElement("mj-breakpoint", ast.Attribute{Name: "width", Value: *h.breakpoint}))
+	}
+
+	for _, s := range h.styles {
+		node := contentElement("mj-style", s.css)
+
+		if s.inline {
+			node.SetAttr("inline", "inline")
+		}
+
+		mjHead.AppendChild(node)
+	}
+
+	if len(h.htmlAttributes) == 0 {
+		return
+	}
+
+	attributes := ast.NewElement("mj-html-attributes")
+
+	for _, a := range h.htmlAttributes {
+		selector := ast.NewElement("mj-selector", ast.Attribute{Name: "path", Value: a.selector})
+
+		for _, name := range sortedKeys(a.attributes) {
+			selector.AppendChild(contentElement("mj-html-attribute", a.attributes[name], ast.Attribute{Name: "name", Value: name}))
+		}
+
+		attributes.AppendChild(selector)
+	}
+
+	mjHead.AppendChild(attributes)
+}
+
+func contentElement(tag string, content string, attributes ...ast.Attribute) *ast.Node {
+	node := ast.NewElement(tag, attributes...)
+	node.Content = content
+	return node
+}
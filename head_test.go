@@ -0,0 +1,96 @@
+package mjml
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestHeadInjection(t *testing.T) {
+
+	o := newOptions()
+
+	for _, opt := range []ToHTMLOption{
+		WithTitle("New title"),
+		WithPreview("New preview"),
+		WithBreakpoint("320px"),
+		WithStyle(".a { color: red; }", false),
+		WithStyle(".b { color: blue; }", true),
+		WithHTMLAttributes(".custom div", map[string]string{"data-id": "42", "data-name": "test"}),
+	} {
+		opt(o)
+	}
+
+	input := `<mjml>
+  <mj-head>
+    <mj-title>Old title</mj-title>
+    <mj-preview>Old preview</mj-preview>
+    <mj-style>.a { color: green; }</mj-style>
+  </mj-head>
+  <mj-body></mj-body>
+</mjml>`
+
+	result, _, _, err := o.local.transform(context.Background(), input)
+
+	if err != nil {
+		t.Fatalf("Error injecting head elements: %s", err)
+	}
+
+	expected := `<mjml>
+  <mj-head>
+    <mj-style>.a { color: green; }</mj-style>
+    <mj-title>New title</mj-title>
+    <mj-preview>New preview</mj-preview>
+    <mj-breakpoint width="320px" />
+    <mj-style>.a { color: red; }</mj-style>
+    <mj-style inline="inline">.b { color: blue; }</mj-style>
+    <mj-html-attributes>
+      <mj-selector path=".custom div">
+        <mj-html-attribute name="data-id">42</mj-html-attribute>
+        <mj-html-attribute name="data-name">test</mj-html-attribute>
+      </mj-selector>
+    </mj-html-attributes>
+  </mj-head>
+  <mj-body />
+</mjml>
+`
+
+	if result != expected {
+		t.Errorf("Injected mjml does not match expected mjml:\n%s", result)
+	}
+}
+
+func TestHeadInjectionToHTML(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text css-class="custom">Hello</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	output, err := ToHTML(context.Background(), input,
+		WithTitle("Hello & welcome"),
+		WithPreview("Preview text"),
+		WithStyle(".custom { font-weight: bold; }", false),
+		WithHTMLAttributes(".custom div", map[string]string{"data-id": "42"}),
+	)
+
+	if err != nil {
+		t.Fatalf("Error converting mjml to html: %s", err)
+	}
+
+	for _, expected := range []string{
+		"<title>Hello & welcome</title>",
+		"Preview text",
+		".custom { font-weight: bold; }",
+		`data-id="42"`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q", expected)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package mjml
 
+import "maps"
+
 type HTMLMinifierQuoteCharacter string
 
 const (
@@ -52,218 +54,223 @@ type htmlMinifierOptions struct {
 	data map[string]interface{}
 }
 
+// clone copies the html-minifier options for setters to modify
+func (o *htmlMinifierOptions) clone() htmlMinifierOptions {
+	return htmlMinifierOptions{data: maps.Clone(o.data)}
+}
+
 func (o *htmlMinifierOptions) CaseSensitive(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["caseSensitive"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CollapseBooleanAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["collapseBooleanAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CollapseInlineTagWhitespace(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["collapseInlineTagWhitespace"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CollapseWhitespace(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["collapseWhitespace"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) ConservativeCollapse(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["conservativeCollapse"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) ContinueOnParseError(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["continueOnParseError"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CustomAttrAssign(regexes []string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["customAttrAssign"] = regexes
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CustomAttrCollapse(regex string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["customAttrCollapse"] = regex
 	return &ret
 }
 
 func (o *htmlMinifierOptions) CustomAttrSurround(regexes []string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["customAttrSurround"] = regexes
 	return &ret
 }
 
 func (o *htmlMinifierOptions) DecodeEntities(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["decodeEntities"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) HTML5(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["html5"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) IgnoreCustomComments(regexes []string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["ignoreCustomComments"] = regexes
 	return &ret
 }
 
 func (o *htmlMinifierOptions) IgnoreCustomFragments(regexes []string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["ignoreCustomFragments"] = regexes
 	return &ret
 }
 
 func (o *htmlMinifierOptions) IncludeAutoGeneratedTags(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["includeAutoGeneratedTags"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) KeepClosingSlash(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["keepClosingSlash"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) MaxLineLength(lineLength uint) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["maxLineLength"] = lineLength
 	return &ret
 }
 
 func (o *htmlMinifierOptions) MinifyCSS(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["minifyCSS"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) MinifyURLs(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["minifyURLs"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) PreserveLineBreaks(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserveLineBreaks"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) PreventAttributesEscaping(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preventAttributesEscaping"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) ProcessConditionalComments(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["processConditionalComments"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) ProcessScripts(scriptTypes []string) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["processScripts"] = scriptTypes
 	return &ret
 }
 
 func (o *htmlMinifierOptions) QuoteCharacter(quoteCharacter HTMLMinifierQuoteCharacter) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["quoteCharacter"] = quoteCharacter
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveAttributeQuotes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeAttributeQuotes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveComments(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeComments"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveEmptyAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeEmptyAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveEmptyElements(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeEmptyElements"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveOptionalTags(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeOptionalTags"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveRedundantAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeRedundantAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveScriptTypeAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeScriptTypeAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveStyleLinkTypeAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeStyleLinkTypeAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) RemoveTagWhitespace(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeTagWhitespace"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) SortAttributes(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["sortAttributes"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) SortClassName(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["sortClassName"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) TrimCustomFragments(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["trimCustomFragments"] = b
 	return &ret
 }
 
 func (o *htmlMinifierOptions) UseShortDoctype(b bool) HTMLMinifierOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["useShortDoctype"] = b
 	return &ret
 }
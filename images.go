@@ -0,0 +1,219 @@
+package mjml
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	stdmime "mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// InlineImage is an image embedded in an email as an attachment, which the html references using cid:ContentID
+type InlineImage struct {
+	// ContentID identifies the image, without angle brackets
+	ContentID string
+
+	ContentType string
+
+	// Filename is the name of the image file, which is empty if the source does not have one
+	Filename string
+
+	Data []byte
+}
+
+// ImageLoader loads the images referenced by templates. LoadImage returns an error wrapping fs.ErrNotExist for
+// sources it does not provide, which are left as they are. If the returned content type is empty, it is detected from
+// the file extension or the data.
+type ImageLoader interface {
+	LoadImage(ctx context.Context, src string) (data []byte, contentType string, err error)
+}
+
+// ImageLoaderFunc is a function implementing ImageLoader
+type ImageLoaderFunc func(ctx context.Context, src string) ([]byte, string, error)
+
+func (f ImageLoaderFunc) LoadImage(ctx context.Context, src string) ([]byte, string, error) {
+	return f(ctx, src)
+}
+
+// FSImageLoader returns an ImageLoader reading images with relative or absolute paths from fsys. Absolute paths are
+// resolved relative to the root of fsys, and URLs with a scheme or host are not loaded.
+func FSImageLoader(fsys fs.FS) ImageLoader {
+	return ImageLoaderFunc(func(_ context.Context, src string) ([]byte, string, error) {
+		u, err := url.Parse(src)
+
+		if err != nil || u.Scheme != "" || u.Host != "" {
+			return nil, "", fmt.Errorf("%s: %w", src, fs.ErrNotExist)
+		}
+
+		data, err := fs.ReadFile(fsys, strings.TrimPrefix(path.Clean("/"+u.Path), "/"))
+
+		return data, "", err
+	})
+}
+
+// MapImageLoader returns an ImageLoader providing images from memory, keyed by their source in the template
+func MapImageLoader(images map[string][]byte) ImageLoader {
+	return ImageLoaderFunc(func(_ context.Context, src string) ([]byte, string, error) {
+		data, ok := images[src]
+
+		if !ok {
+			return nil, "", fmt.Errorf("%s: %w", src, fs.ErrNotExist)
+		}
+
+		return data, "", nil
+	})
+}
+
+// WithInlineImages embeds the images of mj-image, the background of mj-hero and the icons of mj-social-element, for
+// recipients blocking remote images. The sources are loaded using loader and replaced by cid: references before the
+// template is compiled, so every place mjml outputs them references the attachment. The images are returned in
+// Result.Images, with identical images embedded once. Sources set using mj-class and mj-attributes are embedded as
+// well, and mj-social-element without a src embeds the icon mjml uses for its network.
+func WithInlineImages(loader ImageLoader) ToHTMLOption {
+	return func(o options) {
+		o.local.imageLoader = loader
+	}
+}
+
+// imageAttributes are the attributes of the components whose images are embedded by WithInlineImages
+var imageAttributes = map[string]string{
+	"mj-image":          "src",
+	"mj-hero":           "background-url",
+	"mj-social-element": "src",
+}
+
+// socialIconsURL is the URL of the icons of the social networks built into mj-social-element
+const socialIconsURL = "https://www.mailjet.com/images/theme/v1/icons/ico-social/"
+
+// socialIcons are the icons mjml uses for mj-social-element without a src, by network. The -noshare variants of the
+// networks use the same icons.
+var socialIcons = map[string]string{
+	"facebook":   "facebook.png",
+	"twitter":    "twitter.png",
+	"x":          "twitter-x.png",
+	"google":     "google-plus.png",
+	"pinterest":  "pinterest.png",
+	"linkedin":   "linkedin.png",
+	"instagram":  "instagram.png",
+	"web":        "web.png",
+	"snapchat":   "snapchat.png",
+	"youtube":    "youtube.png",
+	"tumblr":     "tumblr.png",
+	"github":     "github.png",
+	"xing":       "xing.png",
+	"vimeo":      "vimeo.png",
+	"medium":     "medium.png",
+	"soundcloud": "soundcloud.png",
+	"dribbble":   "dribbble.png",
+}
+
+// inlineImages loads the images referenced by the document and replaces their sources by cid: references
+func inlineImages(ctx context.Context, root *ast.Node, loader ImageLoader) ([]InlineImage, error) {
+	var (
+		images  []InlineImage
+		sources = map[string]string{}
+		ids     = map[string]bool{}
+		err     error
+	)
+
+	declared := ast.NewAttributes(root)
+
+	root.Walk(func(n *ast.Node) bool {
+		if err != nil || n.Type != ast.ElementNode || n.Tag == "mj-head" {
+			return false
+		}
+
+		name, ok := imageAttributes[n.Tag]
+
+		if !ok {
+			return true
+		}
+
+		src, _ := declared.Get(n, name)
+
+		if src == "" && n.Tag == "mj-social-element" {
+			network, _ := declared.Get(n, "name")
+
+			if icon, ok := socialIcons[strings.TrimSuffix(network, "-noshare")]; ok {
+				src = socialIconsURL + icon
+			}
+		}
+
+		if src == "" || strings.HasPrefix(src, "cid:") || strings.HasPrefix(src, "data:") {
+			return true
+		}
+
+		if contentID, ok := sources[src]; ok {
+			n.SetAttr(name, "cid:"+contentID)
+			return true
+		}
+
+		var image InlineImage
+
+		image, err = loadImage(ctx, loader, src)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+			return true
+		}
+
+		if err != nil {
+			err = fmt.Errorf("error loading image %s: %w", src, err)
+			return false
+		}
+
+		sources[src] = image.ContentID
+		n.SetAttr(name, "cid:"+image.ContentID)
+
+		if !ids[image.ContentID] {
+			ids[image.ContentID] = true
+			images = append(images, image)
+		}
+
+		return true
+	})
+
+	return images, err
+}
+
+func loadImage(ctx context.Context, loader ImageLoader, src string) (InlineImage, error) {
+	data, contentType, err := loader.LoadImage(ctx, src)
+
+	if err != nil {
+		return InlineImage{}, err
+	}
+
+	var filename string
+
+	if u, err := url.Parse(src); err == nil {
+		if base := path.Base(u.Path); base != "." && base != "/" {
+			filename = base
+		}
+	}
+
+	if contentType == "" {
+		contentType = stdmime.TypeByExtension(path.Ext(filename))
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	// images are identified by their contents, so identical images loaded from different sources are embedded once
+	sum := sha256.Sum256(data)
+
+	return InlineImage{
+		ContentID:   hex.EncodeToString(sum[:12]) + "@mjml-go",
+		ContentType: contentType,
+		Filename:    filename,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestInlineImages(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"images/logo.png":      {Data: []byte("\x89PNG\r\n\x1a\nlogo")},
+		"images/logo-copy.png": {Data: []byte("\x89PNG\r\n\x1a\nlogo")},
+		"images/hero.jpg":      {Data: []byte("\xff\xd8\xffhero")},
+		"icons/x":              {Data: []byte("GIF89aicon")},
+	}
+
+	input := `<mjml>
+  <mj-body>
+    <mj-hero background-url="/images/hero.jpg" background-height="200px" background-width="600px">
+      <mj-text>Hello</mj-text>
+    </mj-hero>
+    <mj-section>
+      <mj-column>
+        <mj-image src="images/logo.png" alt="Logo" />
+        <mj-image src="images/logo.png?v=2" alt="Logo" />
+        <mj-image src="images/logo-copy.png" alt="Logo" />
+        <mj-image src="https://example.com/remote.png" alt="Remote" />
+        <mj-social>
+          <mj-social-element name="custom" src="icons/x" href="https://example.com">Example</mj-social-element>
+        </mj-social>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	result, err := Compile(context.Background(), input, WithInlineImages(FSImageLoader(fsys)))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if len(result.Images) != 3 {
+		t.Fatalf("Expected 3 images, got %d", len(result.Images))
+	}
+
+	expected := []InlineImage{
+		{ContentType: "image/jpeg", Filename: "hero.jpg"},
+		{ContentType: "image/png", Filename: "logo.png"},
+		{ContentType: "image/gif", Filename: "x"},
+	}
+
+	for i, image := range result.Images {
+		if image.ContentType != expected[i].ContentType || image.Filename != expected[i].Filename {
+			t.Errorf("Expected image %d to be %s (%s), got %s (%s)", i, expected[i].Filename, expected[i].ContentType, image.Filename, image.ContentType)
+		}
+
+		if !strings.Contains(result.HTML, "cid:"+image.ContentID) {
+			t.Errorf("Expected html to reference %s", image.ContentID)
+		}
+	}
+
+	for _, src := range []string{"hero.jpg", "images/logo", "icons/x"} {
+		if strings.Contains(result.HTML, src) {
+			t.Errorf("Expected %s to be replaced in html", src)
+		}
+	}
+
+	if !strings.Contains(result.HTML, "https://example.com/remote.png") {
+		t.Error("Expected sources not provided by the loader to be left as they are")
+	}
+
+	images := map[string][]byte{"https://example.com/remote.png": []byte("\x89PNG\r\n\x1a\nremote")}
+
+	result, err = Compile(context.Background(), input, WithInlineImages(MapImageLoader(images)))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if len(result.Images) != 1 || result.Images[0].Filename != "remote.png" || strings.Contains(result.HTML, "https://example.com/remote.png") {
+		t.Errorf("Expected the remote image to be embedded, got: %v", result.Images)
+	}
+
+	loaderErr := errors.New("connection refused")
+
+	_, err = Compile(context.Background(), input, WithInlineImages(ImageLoaderFunc(func(ctx context.Context, src string) ([]byte, string, error) {
+		return nil, "", loaderErr
+	})))
+
+	if !errors.Is(err, loaderErr) {
+		t.Errorf("Expected loader error, got: %v", err)
+	}
+}
+
+func TestInlineImagesDeclaredAttributes(t *testing.T) {
+
+	facebook := socialIconsURL + "facebook.png"
+
+	images := map[string][]byte{
+		"https://example.com/logo.png": []byte("\x89PNG\r\n\x1a\nlogo"),
+		"https://example.com/hero.jpg": []byte("\xff\xd8\xffhero"),
+		facebook:                       []byte("\x89PNG\r\n\x1a\nfacebook"),
+	}
+
+	input := `<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-image src="https://example.com/logo.png" />
+      <mj-class name="hero" background-url="https://example.com/hero.jpg" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body>
+    <mj-hero mj-class="hero" background-height="200px" background-width="600px">
+      <mj-text>Hello</mj-text>
+    </mj-hero>
+    <mj-section>
+      <mj-column>
+        <mj-image alt="Logo" />
+        <mj-social>
+          <mj-social-element name="facebook-noshare" href="https://example.com">Facebook</mj-social-element>
+        </mj-social>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	result, err := Compile(context.Background(), input, WithInlineImages(MapImageLoader(images)))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if len(result.Images) != 3 {
+		t.Fatalf("Expected 3 images, got %d", len(result.Images))
+	}
+
+	for src := range images {
+		if strings.Contains(result.HTML, src) {
+			t.Errorf("Expected %s to be replaced in html", src)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package mjml
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// WithIncludeFS resolves mj-include tags by reading the included files from fsys before the template is compiled.
+// Paths are resolved relative to the directory of the file path set using WithFilePath, or the root of fsys.
+// Errors in included files are reported on the line of the mj-include tag.
+func WithIncludeFS(fsys fs.FS) ToHTMLOption {
+	return func(o options) {
+		o.local.includeFS = fsys
+	}
+}
+
+// WithFilePath sets the path of the template within the file system set using WithIncludeFS
+func WithFilePath(filePath string) ToHTMLOption {
+	return func(o options) {
+		o.local.filePath = filePath
+	}
+}
+
+// WithIgnoreIncludes removes mj-include tags instead of resolving them, like the ignoreIncludes option of mjml
+func WithIgnoreIncludes(ignoreIncludes bool) ToHTMLOption {
+	return func(o options) {
+		o.local.ignoreIncludes = ignoreIncludes
+		o.data["ignoreIncludes"] = ignoreIncludes
+	}
+}
+
+// IncludedFiles returns the paths of the files included by the template at filePath in fsys, including the files
+// included by them. If an include cannot be resolved, the error is returned along with the files found so far, which
+// include the file that could not be read, so that callers can watch for it to be created.
+func IncludedFiles(fsys fs.FS, filePath string) ([]string, error) {
+	contents, err := fs.ReadFile(fsys, filePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := ast.Parse(string(contents))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", filePath, err)
+	}
+
+	r := includeResolver{fsys: fsys, stack: []string{path.Clean(filePath)}}
+
+	if err := r.resolve(root, root, filePath); err != nil {
+		return r.included, err
+	}
+
+	return r.included, nil
+}
+
+type includeResolver struct {
+	fsys     fs.FS
+	stack    []string
+	included []string
+}
+
+// resolve replaces the mj-include tags in the descendants of node, which are resolved relative to filePath
+func (r *includeResolver) resolve(root *ast.Node, node *ast.Node, filePath string) error {
+	children := make([]*ast.Node, 0, len(node.Children))
+
+	for _, child := range node.Children {
+		if child.Type != ast.ElementNode || child.Tag != "mj-include" {
+			if err := r.resolve(root, child, filePath); err != nil {
+				return err
+			}
+
+			children = append(children, child)
+			continue
+		}
+
+		included, err := r.include(root, node, child, filePath)
+
+		var mjmlError Error
+
+		if errors.As(err, &mjmlError) {
+			return err
+		}
+
+		if err != nil {
+			mjmlError = Error{Message: "Include error"}
+			mjmlError.addDetail(child, err.Error())
+			return mjmlError
+		}
+
+		children = append(children, included...)
+	}
+
+	node.Children = children
+
+	return nil
+}
+
+// include returns the nodes that replace the mj-include tag in parent
+func (r *includeResolver) include(root *ast.Node, parent *ast.Node, include *ast.Node, filePath string) ([]*ast.Node, error) {
+	includePath, _ := include.Attr("path")
+	includeType, _ := include.Attr("type")
+
+	if includePath == "" {
+		return nil, errors.New("mj-include has no path")
+	}
+
+	if includeType == "" && path.Ext(includePath) != ".mjml" {
+		includePath += ".mjml"
+	}
+
+	resolved := path.Join(path.Dir(filePath), includePath)
+
+	if path.IsAbs(includePath) {
+		resolved = path.Clean(strings.TrimPrefix(includePath, "/"))
+	}
+
+	for _, p := range r.stack {
+		if p == resolved {
+			return nil, fmt.Errorf("mj-include of %s is circular", includePath)
+		}
+	}
+
+	if !slices.Contains(r.included, resolved) {
+		r.included = append(r.included, resolved)
+	}
+
+	contents, err := fs.ReadFile(r.fsys, resolved)
+
+	if err != nil {
+		return nil, fmt.Errorf("mj-include fails to read file %s: %w", includePath, err)
+	}
+
+	switch includeType {
+	case "css":
+		style := ast.NewElement("mj-style")
+		style.Content = string(contents)
+		style.Line = include.Line
+
+		if inline, _ := include.Attr("css-inline"); inline == "inline" {
+			style.SetAttr("inline", "inline")
+		}
+
+		if parent.Tag == "mj-head" {
+			return []*ast.Node{style}, nil
+		}
+
+		head(root).AppendChild(style)
+
+		return nil, nil
+
+	case "html":
+		raw := ast.NewElement("mj-raw")
+		raw.Content = string(contents)
+		raw.Line = include.Line
+
+		return []*ast.Node{raw}, nil
+	}
+
+	nodes, err := ast.ParseFragment(string(contents))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", includePath, err)
+	}
+
+	var headNodes, bodyNodes []*ast.Node
+
+	if len(nodes) == 1 && nodes[0].Tag == "mjml" {
+		if h := nodes[0].Child("mj-head"); h != nil {
+			headNodes = h.Children
+		}
+
+		if b := nodes[0].Child("mj-body"); b != nil {
+			bodyNodes = b.Children
+		}
+	} else if parent.Tag == "mj-head" {
+		headNodes = nodes
+	} else {
+		bodyNodes = nodes
+	}
+
+	r.stack = append(r.stack, resolved)
+	defer func() {
+		r.stack = r.stack[:len(r.stack)-1]
+	}()
+
+	headNodes, err = r.resolveIncluded(root, "mj-head", headNodes, include.Line, resolved)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bodyNodes, err = r.resolveIncluded(root, parent.Tag, bodyNodes, include.Line, resolved)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.Tag == "mj-head" {
+		return headNodes, nil
+	}
+
+	if len(headNodes) > 0 {
+		head(root).AppendChild(headNodes...)
+	}
+
+	return bodyNodes, nil
+}
+
+// resolveIncluded resolves the includes in nodes included from filePath, as if they were children of a parent with
+// the given tag. The nodes are reported on the line of the mj-include tag.
+func (r *includeResolver) resolveIncluded(root *ast.Node, parentTag string, nodes []*ast.Node, line int, filePath string) ([]*ast.Node, error) {
+	wrapper := ast.NewElement(parentTag)
+	wrapper.Children = nodes
+
+	for _, node := range nodes {
+		node.Walk(func(n *ast.Node) bool {
+			n.Line = line
+			return true
+		})
+	}
+
+	if err := r.resolve(root, wrapper, filePath); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Children, nil
+}
+
+func expandIncludes(root *ast.Node, fsys fs.FS, filePath string) error {
+	if fsys == nil {
+		return nil
+	}
+
+	r := includeResolver{fsys: fsys, stack: []string{path.Clean(filePath)}}
+
+	return r.resolve(root, root, filePath)
+}
@@ -0,0 +1,165 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var includeFS = fstest.MapFS{
+	"templates/welcome.mjml": {Data: []byte(`<mjml>
+  <mj-head>
+    <mj-include path="../partials/styles.css" type="css" />
+  </mj-head>
+  <mj-body>
+    <mj-include path="../partials/header" />
+    <mj-section>
+      <mj-column>
+        <mj-include path="../partials/greeting.html" type="html" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`)},
+	"partials/header.mjml": {Data: []byte(`<mjml>
+  <mj-head>
+    <mj-title>Header title</mj-title>
+  </mj-head>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-include path="./logo.mjml" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`)},
+	"partials/logo.mjml":     {Data: []byte(`<mj-image src="https://example.com/logo.png" alt="Logo" />`)},
+	"partials/styles.css":    {Data: []byte(`.greeting { color: #ff0000; }`)},
+	"partials/greeting.html": {Data: []byte(`<p class="greeting">Hello from an include</p>`)},
+	"broken/circular.mjml": {Data: []byte(`<mjml>
+  <mj-body>
+
+    <mj-include path="./circular.mjml" />
+  </mj-body>
+</mjml>`)},
+	"broken/missing.mjml": {Data: []byte(`<mjml>
+  <mj-body>
+    <mj-include path="./nested-missing.mjml" />
+  </mj-body>
+</mjml>`)},
+	"broken/nested-missing.mjml": {Data: []byte(`<mj-section>
+  <mj-include path="./does-not-exist.mjml" />
+</mj-section>`)},
+}
+
+func TestIncludes(t *testing.T) {
+
+	input, err := includeFS.ReadFile("templates/welcome.mjml")
+
+	if err != nil {
+		t.Fatalf("Error reading template: %s", err)
+	}
+
+	output, err := ToHTML(context.Background(), string(input), WithIncludeFS(includeFS), WithFilePath("templates/welcome.mjml"))
+
+	if err != nil {
+		t.Fatalf("Error converting mjml with includes to html: %s", err)
+	}
+
+	for _, expected := range []string{
+		"<title>Header title</title>",
+		"https://example.com/logo.png",
+		".greeting { color: #ff0000; }",
+		"Hello from an include",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q", expected)
+		}
+	}
+}
+
+func TestIgnoreIncludes(t *testing.T) {
+
+	input, err := includeFS.ReadFile("broken/missing.mjml")
+
+	if err != nil {
+		t.Fatalf("Error reading template: %s", err)
+	}
+
+	if _, err := ToHTML(context.Background(), string(input), WithIncludeFS(includeFS), WithFilePath("broken/missing.mjml"), WithIgnoreIncludes(true)); err != nil {
+		t.Errorf("Expected includes to be ignored, got: %s", err)
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+
+	tests := map[string]int{
+		"broken/circular.mjml": 4,
+		"broken/missing.mjml":  3,
+	}
+
+	for file, line := range tests {
+		input, err := includeFS.ReadFile(file)
+
+		if err != nil {
+			t.Fatalf("Error reading template: %s", err)
+		}
+
+		_, err = ToHTML(context.Background(), string(input), WithIncludeFS(includeFS), WithFilePath(file))
+
+		var mjmlError Error
+
+		if !errors.As(err, &mjmlError) {
+			t.Errorf("Expected an mjml error for %s, got: %v", file, err)
+			continue
+		}
+
+		if len(mjmlError.Details) != 1 || mjmlError.Details[0].Line != line || mjmlError.Details[0].TagName != "mj-include" {
+			t.Errorf("Expected an include error on line %d of %s: %s", line, file, mjmlError)
+		}
+	}
+}
+
+func TestIncludedFiles(t *testing.T) {
+
+	files, err := IncludedFiles(includeFS, "templates/welcome.mjml")
+
+	if err != nil {
+		t.Fatalf("Error getting included files: %s", err)
+	}
+
+	expected := []string{
+		"partials/styles.css",
+		"partials/header.mjml",
+		"partials/logo.mjml",
+		"partials/greeting.html",
+	}
+
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("Included files do not match expected files: %v", files)
+	}
+}
+
+func TestIncludedFilesMissing(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"welcome.mjml": {Data: []byte(`<mjml><mj-body><mj-include path="./header.mjml" /></mj-body></mjml>`)},
+		"header.mjml":  {Data: []byte(`<mj-include path="./logo.mjml" /><mj-include path="./missing.mjml" />`)},
+		"logo.mjml":    {Data: []byte(`<mj-image src="logo.png" />`)},
+		"unused.mjml":  {Data: []byte(`<mj-text>Unused</mj-text>`)},
+	}
+
+	files, err := IncludedFiles(fsys, "welcome.mjml")
+
+	if err == nil {
+		t.Fatal("Expected an error for the missing include")
+	}
+
+	expected := []string{"header.mjml", "logo.mjml", "missing.mjml"}
+
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected the files found before the error, including the missing file, got: %v", files)
+	}
+}
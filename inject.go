@@ -0,0 +1,93 @@
+package mjml
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// WithTrackingPixel adds an open tracking pixel loading url to the end of the body of the compiled html
+func WithTrackingPixel(url string) ToHTMLOption {
+	return func(o options) {
+		o.local.trackingPixel = url
+	}
+}
+
+// WithFooterHTML adds html to the end of the body of the compiled html, after the last section. The footer is
+// centered with the width of the sections and wrapped in a table for Outlook, like the sections generated by mjml.
+// The html is output as it is, so it is not minified or beautified.
+func WithFooterHTML(html string) ToHTMLOption {
+	return func(o options) {
+		o.local.footerHTML = html
+	}
+}
+
+// defaultBodyWidth is the default width of mj-body, which is used for the footer if the template has no sections
+const defaultBodyWidth = 600
+
+var sectionWidth = regexp.MustCompile(`margin:0px auto;max-width:(\d+)px;`)
+
+// inject adds the footer and tracking pixel to the end of the div wrapping the content of the body, outside the
+// conditional comments closing the tables of the last section for Outlook
+func (l *localOptions) inject(document string) (string, error) {
+	if l.footerHTML == "" && l.trackingPixel == "" {
+		return document, nil
+	}
+
+	bodyEnd := strings.LastIndex(document, "</body>")
+
+	if bodyEnd < 0 {
+		return "", errors.New("document generated by mjml does not have a body")
+	}
+
+	wrapperEnd := strings.LastIndex(document[:bodyEnd], "</div>")
+
+	if wrapperEnd < 0 {
+		return "", errors.New("document generated by mjml does not have a body wrapper")
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(document[:wrapperEnd])
+
+	if l.footerHTML != "" {
+		width := fmt.Sprint(defaultBodyWidth)
+
+		if match := sectionWidth.FindStringSubmatch(document); match != nil {
+			width = match[1]
+		}
+
+		fmt.Fprintf(&sb, `<!--[if mso | IE]><table align="center" border="0" cellpadding="0" cellspacing="0" `+
+			`role="presentation" style="width:%[1]spx;" width="%[1]s" ><tr><td><![endif]-->`+
+			`<div style="margin:0px auto;max-width:%[1]spx;">%[2]s</div>`+
+			`<!--[if mso | IE]></td></tr></table><![endif]-->`, width, l.footerHTML)
+	}
+
+	if l.trackingPixel != "" {
+		fmt.Fprintf(&sb, `<img src="%s" width="1" height="1" alt="" border="0" `+
+			`style="display:block;height:1px;width:1px;border:0;" />`, html.EscapeString(l.trackingPixel))
+	}
+
+	sb.WriteString(document[wrapperEnd:])
+
+	return sb.String(), nil
+}
+
+// checkTrackingPixel checks that the tracking pixel is an absolute http or https URL
+func (l *localOptions) checkTrackingPixel() error {
+	if l.trackingPixel == "" {
+		return nil
+	}
+
+	u, err := url.Parse(l.trackingPixel)
+
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return OptionError{Option: "trackingPixel", Err: errors.New("must be an absolute http or https URL")}
+	}
+
+	return nil
+}
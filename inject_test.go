@@ -0,0 +1,58 @@
+package mjml
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestInject(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body width="500px">
+    <mj-section>
+      <mj-column>
+        <mj-text>Hello</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	footer := `<p style="font-size:11px;">You received this email because you signed up.</p>`
+	pixel := "https://track.example.com/open?id=1&user=2"
+
+	expected := `<!--[if mso | IE]><table align="center" border="0" cellpadding="0" cellspacing="0" role="presentation" style="width:500px;" width="500" ><tr><td><![endif]-->` +
+		`<div style="margin:0px auto;max-width:500px;">` + footer + `</div><!--[if mso | IE]></td></tr></table><![endif]-->` +
+		`<img src="https://track.example.com/open?id=1&amp;user=2" width="1" height="1" alt="" border="0" style="display:block;height:1px;width:1px;border:0;" />`
+
+	for name, option := range map[string]ToHTMLOption{"minified": WithMinify(true), "beautified": WithBeautify(true)} {
+		output, err := ToHTML(context.Background(), input, option, WithFooterHTML(footer), WithTrackingPixel(pixel))
+
+		if err != nil {
+			t.Fatalf("Error compiling %s template: %s", name, err)
+		}
+
+		footerStart := strings.Index(output, expected)
+
+		if footerStart < 0 {
+			t.Fatalf("Expected %s output to contain footer and tracking pixel, got:\n%s", name, output)
+		}
+
+		before := strings.TrimSpace(output[:footerStart])
+		after := strings.Join(strings.Fields(output[footerStart+len(expected):]), "")
+
+		if !strings.HasSuffix(before, "<![endif]-->") || after != "</div></body></html>" {
+			t.Errorf("Expected footer and tracking pixel after the last section in the %s body wrapper, got:\n%s", name, output)
+		}
+	}
+
+	output, err := ToHTML(context.Background(), "<mjml><mj-body></mj-body></mjml>", WithFooterHTML(footer))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if !strings.Contains(output, `<div style="margin:0px auto;max-width:600px;">`+footer+`</div>`) {
+		t.Errorf("Expected footer with the default width, got:\n%s", output)
+	}
+}
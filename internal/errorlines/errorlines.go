@@ -0,0 +1,32 @@
+// Package errorlines parses the lines referenced in the messages of errors thrown by mjml when using the strict
+// validation level, which do not have details.
+package errorlines
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// messageLine matches a line referenced in an error message
+var messageLine = regexp.MustCompile(`Line (\d+) of`)
+
+// Parse returns the lines referenced in message
+func Parse(message string) []int {
+	var lines []int
+
+	for _, match := range messageLine.FindAllStringSubmatch(message, -1) {
+		line, _ := strconv.Atoi(match[1])
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// Replace replaces the lines referenced in message by the lines returned by mapLine
+func Replace(message string, mapLine func(line int) int) string {
+	return messageLine.ReplaceAllStringFunc(message, func(match string) string {
+		line, _ := strconv.Atoi(messageLine.FindStringSubmatch(match)[1])
+		return fmt.Sprintf("Line %d of", mapLine(line))
+	})
+}
@@ -1,4 +1,9 @@
-import mjml2html from "mjml-browser";
+import mjml2html, {
+  BodyComponent,
+  HeadComponent,
+  registerComponent,
+  registerDependencies,
+} from "mjml-browser";
 import htmlMinifier from "html-minifier";
 import jsBeautify from "js-beautify";
 
@@ -17,6 +22,8 @@ const defaultBeautifyOptions = {
   wrap_attributes_indent_size: 2,
 };
 
+const registeredComponents = {};
+
 export function compile(input) {
   if (!input.mjml) {
     return {
@@ -26,6 +33,18 @@ export function compile(input) {
     };
   }
 
+  if (input.components) {
+    try {
+      registerComponents(input.components);
+    } catch (err) {
+      return {
+        error: {
+          message: err.message,
+        },
+      };
+    }
+  }
+
   let options = {};
 
   if (input.options) {
@@ -113,6 +132,45 @@ export function compile(input) {
   return result;
 }
 
+// Custom components stay registered for the lifetime of the instance, so each component is only evaluated the first
+// time it is seen
+function registerComponents(components) {
+  if (typeof registerComponent !== "function") {
+    throw new Error(
+      "custom components are not supported by this build of mjml-browser"
+    );
+  }
+
+  components.forEach(function (component) {
+    if (registeredComponents[component.name] === component.source) {
+      return;
+    }
+
+    try {
+      const define = new Function(
+        "BodyComponent",
+        "HeadComponent",
+        "registerComponent",
+        "registerDependencies",
+        component.source
+      );
+
+      define(
+        BodyComponent,
+        HeadComponent,
+        registerComponent,
+        registerDependencies
+      );
+    } catch (err) {
+      throw new Error(
+        `error registering component ${component.name}: ${err.message}`
+      );
+    }
+
+    registeredComponents[component.name] = component.source;
+  });
+}
+
 function omit(obj, ...props) {
   const result = { ...obj };
 
@@ -1,5 +1,7 @@
 package mjml
 
+import "maps"
+
 // JuiceOptions is used to construct Juice options to be passed to the MJML compiler
 // Detailed explanations of the options are here: https://github.com/Automattic/juice#options
 type JuiceOptions interface {
@@ -23,86 +25,91 @@ type juiceOptions struct {
 	data map[string]interface{}
 }
 
+// clone copies the juice options for setters to modify, so that options derived from the same options do not share data
+func (o *juiceOptions) clone() juiceOptions {
+	return juiceOptions{data: maps.Clone(o.data)}
+}
+
 func (o *juiceOptions) ApplyAttributesTableElements(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["applyAttributesTableElements"] = b
 	return &ret
 }
 
 func (o *juiceOptions) ApplyHeightAttributes(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["applyHeightAttributes"] = b
 	return &ret
 }
 
 func (o *juiceOptions) ApplyStyleTags(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["applyStyleTags"] = b
 	return &ret
 }
 
 func (o *juiceOptions) ApplyWidthAttributes(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["applyWidthAttributes"] = b
 	return &ret
 }
 
 func (o *juiceOptions) ExtraCss(s string) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["extraCss"] = s
 	return &ret
 }
 
 func (o *juiceOptions) InsertPreservedExtraCss(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["insertPreservedExtraCss"] = b
 	return &ret
 }
 
 func (o *juiceOptions) InlinePseudoElements(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["inlinePseudoElements"] = b
 	return &ret
 }
 
 func (o *juiceOptions) PreserveFontFaces(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserveFontFaces"] = b
 	return &ret
 }
 
 func (o *juiceOptions) PreserveImportant(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserveImportant"] = b
 	return &ret
 }
 
 func (o *juiceOptions) PreserveMediaQueries(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserveMediaQueries"] = b
 	return &ret
 }
 
 func (o *juiceOptions) PreserveKeyFrames(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preserveKeyFrames"] = b
 	return &ret
 }
 
 func (o *juiceOptions) PreservePseudos(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["preservePseudos"] = b
 	return &ret
 }
 
 func (o *juiceOptions) RemoveStyleTags(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["removeStyleTags"] = b
 	return &ret
 }
 
 func (o *juiceOptions) XmlMode(b bool) JuiceOptions {
-	ret := *o
+	ret := o.clone()
 	ret.data["xmlMode"] = b
 	return &ret
 }
@@ -0,0 +1,230 @@
+package mjml
+
+import (
+	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// LinkInfo describes a link passed to the function set using WithLinkRewriter
+type LinkInfo struct {
+	// URL is the URL the link points to in the compiled html, with entities decoded
+	URL string
+
+	// Component is the tag of the mjml component the link originates from, such as mj-button, or the component
+	// containing the anchor for anchors in html content, such as mj-text
+	Component string
+
+	// Attributes are the attributes of the component, or of the anchor for anchors in html content
+	Attributes map[string]string
+}
+
+// WithLinkRewriter rewrites the links of the template, for example to add click tracking or UTM parameters. The
+// rewriter is called with the href of each mj-button, mj-image, mj-carousel-image, mj-navbar-link and
+// mj-social-element, including those set using mj-class and mj-attributes, and of each anchor in the html content of
+// components such as mj-text and mj-raw, and returns the URL to use instead. Links are rewritten in the mjml before it
+// is compiled, so they are minified or beautified along with the rest of the output. Links of mj-navbar-link include
+// the base-url of their mj-navbar, and links of mj-social-element sharing a URL are the share URL of the network.
+func WithLinkRewriter(rewriter func(LinkInfo) string) ToHTMLOption {
+	return func(o options) {
+		o.local.linkRewriter = rewriter
+	}
+}
+
+// socialShareURLs are the share URLs of the social networks built into mj-social-element, which mjml uses as the
+// href of elements with an href unless the name of the network ends with -noshare
+var socialShareURLs = map[string]string{
+	"facebook":  "https://www.facebook.com/sharer/sharer.php?u=[[URL]]",
+	"twitter":   "https://twitter.com/intent/tweet?url=[[URL]]",
+	"x":         "https://twitter.com/intent/tweet?url=[[URL]]",
+	"google":    "https://plus.google.com/share?url=[[URL]]",
+	"pinterest": "https://pinterest.com/pin/create/button/?url=[[URL]]&media=&description=",
+	"linkedin":  "https://www.linkedin.com/shareArticle?mini=true&url=[[URL]]&title=&summary=&source=",
+	"tumblr":    "https://www.tumblr.com/widgets/share/tool?canonicalUrl=[[URL]]",
+	"xing":      "https://www.xing.com/app/user?op=share&url=[[URL]]",
+}
+
+// rewriteLinks replaces the links of the mj-body using rewriter. Links are resolved like mjml does, including those set
+// using mj-class and mj-attributes.
+func rewriteLinks(root *ast.Node, rewriter func(LinkInfo) string) {
+	body := root.Child("mj-body")
+
+	if body == nil {
+		return
+	}
+
+	declared := ast.NewAttributes(root)
+
+	body.Walk(func(n *ast.Node) bool {
+		if n.Type != ast.ElementNode {
+			return false
+		}
+
+		switch n.Tag {
+		case "mj-button", "mj-image", "mj-carousel-image":
+			if href := declaredAttr(declared, n, "href"); href != "" {
+				setLink(n, href, rewriter)
+			}
+
+		case "mj-navbar":
+			rewriteNavbarLinks(declared, n, rewriter)
+
+		case "mj-social-element":
+			rewriteSocialLink(declared, n, rewriter)
+		}
+
+		if ast.IsEndingTag(n.Tag) && n.Content != "" {
+			n.Content = rewriteContentLinks(n.Tag, n.Content, rewriter)
+		}
+
+		return true
+	})
+}
+
+// rewriteNavbarLinks rewrites the links of an mj-navbar. The base-url of the navbar is prepended to the links and
+// removed, as mjml would prepend it to the rewritten links otherwise.
+func rewriteNavbarLinks(declared *ast.Attributes, navbar *ast.Node, rewriter func(LinkInfo) string) {
+	baseURL := declaredAttr(declared, navbar, "base-url")
+
+	for _, link := range navbar.Children {
+		if link.Type != ast.ElementNode || link.Tag != "mj-navbar-link" {
+			continue
+		}
+
+		href := baseURL + declaredAttr(declared, link, "href")
+
+		if href == "" {
+			continue
+		}
+
+		if baseURL != "" {
+			link.SetAttr("href", escapeAttr(href))
+		}
+
+		setLink(link, href, rewriter)
+	}
+
+	navbar.RemoveAttr("base-url")
+
+	// an empty base-url overrides one set using mj-class or mj-attributes
+	if _, ok := declared.Get(navbar, "base-url"); ok {
+		navbar.SetAttr("base-url", "")
+	}
+}
+
+// rewriteSocialLink rewrites the link of an mj-social-element. Networks sharing the href are changed to their
+// -noshare variant, so mjml uses the rewritten share URL as it is.
+func rewriteSocialLink(declared *ast.Attributes, element *ast.Node, rewriter func(LinkInfo) string) {
+	href := declaredAttr(declared, element, "href")
+
+	if href == "" {
+		return
+	}
+
+	name := declaredAttr(declared, element, "name")
+	shareURL, ok := socialShareURLs[name]
+
+	if !ok {
+		setLink(element, href, rewriter)
+		return
+	}
+
+	href = strings.Replace(shareURL, "[[URL]]", href, 1)
+
+	if rewritten := rewriter(linkInfo(element, href)); rewritten != href {
+		element.SetAttr("name", name+"-noshare")
+		element.SetAttr("href", escapeAttr(rewritten))
+	}
+}
+
+// setLink sets the href of a component to the rewritten URL, leaving it as it is if the URL is not changed
+func setLink(n *ast.Node, href string, rewriter func(LinkInfo) string) {
+	if rewritten := rewriter(linkInfo(n, href)); rewritten != href {
+		n.SetAttr("href", escapeAttr(rewritten))
+	}
+}
+
+func linkInfo(n *ast.Node, href string) LinkInfo {
+	attributes := make(map[string]string, len(n.Attributes))
+
+	for _, a := range n.Attributes {
+		attributes[a.Name] = html.UnescapeString(a.Value)
+	}
+
+	return LinkInfo{URL: href, Component: n.Tag, Attributes: attributes}
+}
+
+// rewriteContentLinks rewrites the anchors in the html content of a component. Only the start tags of rewritten
+// anchors are changed, the rest of the content is kept exactly as it is.
+func rewriteContentLinks(component string, content string, rewriter func(LinkInfo) string) string {
+	if !strings.Contains(content, "<") {
+		return content
+	}
+
+	var sb strings.Builder
+
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tt := z.Next()
+
+		if tt == html.ErrorToken {
+			// the tokenizer does not return partial tokens at the end of the content
+			sb.Write(z.Raw())
+			return sb.String()
+		}
+
+		// the raw bytes have to be copied before the token is read, which lowercases tag names in place
+		raw := string(z.Raw())
+
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			sb.WriteString(raw)
+			continue
+		}
+
+		token := z.Token()
+
+		if token.DataAtom != atom.A || !rewriteAnchor(&token, component, rewriter) {
+			sb.WriteString(raw)
+			continue
+		}
+
+		sb.WriteString(token.String())
+	}
+}
+
+// rewriteAnchor rewrites the href of an anchor, reporting whether it was changed
+func rewriteAnchor(token *html.Token, component string, rewriter func(LinkInfo) string) bool {
+	attributes := make(map[string]string, len(token.Attr))
+	hrefIndex := -1
+
+	for i, a := range token.Attr {
+		attributes[a.Key] = a.Val
+
+		if a.Key == "href" {
+			hrefIndex = i
+		}
+	}
+
+	if hrefIndex < 0 || token.Attr[hrefIndex].Val == "" {
+		return false
+	}
+
+	href := token.Attr[hrefIndex].Val
+	rewritten := rewriter(LinkInfo{URL: href, Component: component, Attributes: attributes})
+
+	if rewritten == href {
+		return false
+	}
+
+	token.Attr[hrefIndex].Val = rewritten
+
+	return true
+}
+
+// escapeAttr escapes ampersands in an attribute value, which is output by mjml as it is
+func escapeAttr(value string) string {
+	return strings.ReplaceAll(value, "&", "&amp;")
+}
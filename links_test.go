@@ -0,0 +1,171 @@
+package mjml
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLinkRewriter(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-button href="https://example.com/buy?id=1&amp;ref=mail">Buy</mj-button>
+        <mj-image src="https://example.com/logo.png" href="https://example.com/home" />
+        <mj-image src="https://example.com/logo.png" />
+        <mj-text>Read <A Class="more" HREF="https://example.com/blog">more</A> or <a href="#top">go up</a> {{unsubscribe}}</mj-text>
+        <mj-navbar base-url="https://example.com">
+          <mj-navbar-link href="/about">About</mj-navbar-link>
+        </mj-navbar>
+        <mj-social>
+          <mj-social-element name="facebook" href="https://example.com/post">Share</mj-social-element>
+          <mj-social-element name="github-noshare" href="https://github.com/Boostport">GitHub</mj-social-element>
+        </mj-social>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	var links []LinkInfo
+
+	rewriter := func(link LinkInfo) string {
+		links = append(links, link)
+
+		if strings.HasPrefix(link.URL, "#") {
+			return link.URL
+		}
+
+		return "https://track.example.com/c?u=" + link.URL + "&utm_source=mjml"
+	}
+
+	output, err := ToHTML(context.Background(), input, WithLinkRewriter(rewriter), WithMinify(true))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	expected := []LinkInfo{
+		{URL: "https://example.com/buy?id=1&ref=mail", Component: "mj-button"},
+		{URL: "https://example.com/home", Component: "mj-image"},
+		{URL: "https://example.com/blog", Component: "mj-text", Attributes: map[string]string{"class": "more", "href": "https://example.com/blog"}},
+		{URL: "#top", Component: "mj-text"},
+		{URL: "https://example.com/about", Component: "mj-navbar-link"},
+		{URL: "https://www.facebook.com/sharer/sharer.php?u=https://example.com/post", Component: "mj-social-element"},
+		{URL: "https://github.com/Boostport", Component: "mj-social-element"},
+	}
+
+	if len(links) != len(expected) {
+		t.Fatalf("Expected %d links, got %d: %v", len(expected), len(links), links)
+	}
+
+	for i, link := range links {
+		if link.URL != expected[i].URL || link.Component != expected[i].Component {
+			t.Errorf("Expected link %d to be %s of %s, got %s of %s", i, expected[i].URL, expected[i].Component, link.URL, link.Component)
+		}
+
+		if expected[i].Attributes != nil && link.Attributes["class"] != expected[i].Attributes["class"] {
+			t.Errorf("Expected attributes %v, got %v", expected[i].Attributes, link.Attributes)
+		}
+	}
+
+	if links[0].Attributes["href"] != "https://example.com/buy?id=1&ref=mail" {
+		t.Errorf("Expected component attributes with entities decoded, got: %v", links[0].Attributes)
+	}
+
+	for _, link := range expected {
+		if strings.HasPrefix(link.URL, "#") {
+			continue
+		}
+
+		href := `href="https://track.example.com/c?u=` + strings.ReplaceAll(link.URL, "&", "&amp;") + `&amp;utm_source=mjml"`
+
+		if !strings.Contains(output, href) {
+			t.Errorf("Expected output to contain %s", href)
+		}
+	}
+
+	for _, unchanged := range []string{`href="#top"`, "{{unsubscribe}}", `class="more"`} {
+		if !strings.Contains(output, unchanged) {
+			t.Errorf("Expected output to contain %s", unchanged)
+		}
+	}
+
+	if !strings.Contains(output, `<body style="word-spacing:normal;"><div`) {
+		t.Error("Expected output to be minified")
+	}
+}
+
+func TestLinkRewriterDeclaredAttributes(t *testing.T) {
+
+	input := `<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-class name="cta" href="https://example.com/buy" />
+      <mj-navbar base-url="https://example.com" />
+      <mj-navbar-link href="/about" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-button mj-class="cta">Buy</mj-button>
+        <mj-navbar>
+          <mj-navbar-link>About</mj-navbar-link>
+        </mj-navbar>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	var links []string
+
+	rewriter := func(link LinkInfo) string {
+		links = append(links, link.URL)
+		return link.URL + "?utm_source=mjml"
+	}
+
+	output, err := ToHTML(context.Background(), input, WithLinkRewriter(rewriter))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	expected := []string{"https://example.com/buy", "https://example.com/about"}
+
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("Expected links %v, got %v", expected, links)
+	}
+
+	for _, link := range expected {
+		if href := `href="` + link + `?utm_source=mjml"`; !strings.Contains(output, href) {
+			t.Errorf("Expected output to contain %s", href)
+		}
+	}
+
+	if strings.Contains(output, "https://example.comhttps://") {
+		t.Error("Expected the base-url of the navbar not to be prepended to rewritten links")
+	}
+}
+
+func TestRewriteContentLinks(t *testing.T) {
+
+	rewriter := func(link LinkInfo) string {
+		return link.URL + "?utm_source=mjml"
+	}
+
+	tests := map[string]string{
+		`1 < 2 <B>bold</B> <!-- <a href="x"> --> <br/>`:                  `1 < 2 <B>bold</B> <!-- <a href="x"> --> <br/>`,
+		`<a href="x" data-track='1'>x</a><a name="top">`:                 `<a href="x?utm_source=mjml" data-track="1">x</a><a name="top">`,
+		`<style>a[href="y"] { color: red; }</style><a href="y`:           `<style>a[href="y"] { color: red; }</style><a href="y`,
+		`<a href="https://example.com/?a=1&amp;b=2">link</a> {{ name }}`: `<a href="https://example.com/?a=1&amp;b=2?utm_source=mjml">link</a> {{ name }}`,
+	}
+
+	for content, expected := range tests {
+		if result := rewriteContentLinks("mj-text", content, rewriter); result != expected {
+			t.Errorf("Expected %s, got %s", expected, result)
+		}
+	}
+}
@@ -0,0 +1,500 @@
+// Package mime builds RFC 5322 email messages from compiled templates, with the HTML and its plain text version as a
+// multipart/alternative body and inline images in a multipart/related body.
+//
+//	result, err := mjml.Compile(ctx, input)
+//	msg, err := mime.FromResult(result)
+//	msg.From = &mail.Address{Name: "Example", Address: "hello@example.com"}
+//	msg.To = []*mail.Address{{Address: "user@example.com"}}
+//	msg.Subject = "Welcome"
+//	err = msg.Send("smtp.example.com:587", auth)
+package mime
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	stdmime "mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+// maxLineLength is the length lines of headers and base64 encoded parts are limited to, as recommended by RFC 5322
+const maxLineLength = 76
+
+// Inline is a file embedded in the message, which is referenced in the html using cid:ContentID
+type Inline struct {
+	// ContentID identifies the file, without angle brackets
+	ContentID string
+
+	// ContentType is the media type of the file, which is detected from its data if it is empty
+	ContentType string
+
+	// Filename is the name of the file shown by email clients
+	Filename string
+
+	Data []byte
+}
+
+// Message is an email containing a compiled template
+type Message struct {
+	From    *mail.Address
+	To      []*mail.Address
+	Cc      []*mail.Address
+	ReplyTo []*mail.Address
+
+	// Bcc recipients are returned by Recipients, but are not written to the headers of the message
+	Bcc []*mail.Address
+
+	Subject string
+
+	// Date is the date of the message, which is the time it is written if it is zero
+	Date time.Time
+
+	// MessageID is the Message-ID of the message without angle brackets, which is generated if it is empty
+	MessageID string
+
+	// Header contains additional headers
+	Header textproto.MIMEHeader
+
+	HTML string
+	Text string
+
+	Inline []Inline
+}
+
+// FromResult returns a message containing the html and plain text version of a compiled template, along with the
+// images embedded using mjml.WithInlineImages
+func FromResult(result *mjml.Result) (*Message, error) {
+	text, err := result.Text()
+
+	if err != nil {
+		return nil, fmt.Errorf("error converting template to text: %w", err)
+	}
+
+	msg := &Message{HTML: result.HTML, Text: text}
+
+	for _, image := range result.Images {
+		msg.Inline = append(msg.Inline, Inline(image))
+	}
+
+	return msg, nil
+}
+
+// Recipients returns the addresses of the To, Cc and Bcc recipients
+func (m *Message) Recipients() []string {
+	var recipients []string
+
+	for _, list := range [][]*mail.Address{m.To, m.Cc, m.Bcc} {
+		for _, address := range list {
+			recipients = append(recipients, address.Address)
+		}
+	}
+
+	return recipients
+}
+
+// Bytes returns the message in the format written by WriteTo
+func (m *Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, err := m.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Send sends the message to its recipients using smtp.SendMail
+func (m *Message) Send(addr string, auth smtp.Auth) error {
+	msg, err := m.Bytes()
+
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(addr, auth, m.From.Address, m.Recipients(), msg)
+}
+
+// WriteTo writes the message with CRLF line endings. Text parts are quoted-printable encoded and inline files are
+// base64 encoded, so no line exceeds 78 characters.
+func (m *Message) WriteTo(w io.Writer) (int64, error) {
+	if m.From == nil {
+		return 0, errors.New("message has no From address")
+	}
+
+	if m.HTML == "" && m.Text == "" {
+		return 0, errors.New("message has no content")
+	}
+
+	body, err := m.body()
+
+	if err != nil {
+		return 0, err
+	}
+
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+
+	if err := m.writeHeaders(cw, body.header); err != nil {
+		return cw.n, err
+	}
+
+	if err := body.write(cw); err != nil {
+		return cw.n, err
+	}
+
+	return cw.n, bw.Flush()
+}
+
+// part is a MIME entity, which consists of headers and a function writing its content
+type part struct {
+	header textproto.MIMEHeader
+	write  func(w io.Writer) error
+}
+
+// body returns the body of the message, which contains the text and html as alternatives, along with the inline files
+// they are related to
+func (m *Message) body() (part, error) {
+	var (
+		content part
+		err     error
+	)
+
+	switch {
+	case m.Text == "":
+		content = textPart("text/html", m.HTML)
+
+	case m.HTML == "":
+		content = textPart("text/plain", m.Text)
+
+	default:
+		content, err = multipartPart("multipart/alternative", nil, textPart("text/plain", m.Text), textPart("text/html", m.HTML))
+
+		if err != nil {
+			return part{}, err
+		}
+	}
+
+	if len(m.Inline) == 0 {
+		return content, nil
+	}
+
+	parts := []part{content}
+
+	for _, inline := range m.Inline {
+		p, err := inlinePart(inline)
+
+		if err != nil {
+			return part{}, err
+		}
+
+		parts = append(parts, p)
+	}
+
+	rootType, _, _ := stdmime.ParseMediaType(content.header.Get("Content-Type"))
+
+	return multipartPart("multipart/related", map[string]string{"type": rootType}, parts...)
+}
+
+func (m *Message) writeHeaders(w io.Writer, contentHeader textproto.MIMEHeader) error {
+	date := m.Date
+
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	messageID := m.MessageID
+
+	if messageID == "" {
+		var err error
+
+		if messageID, err = m.generateMessageID(); err != nil {
+			return err
+		}
+	}
+
+	headers := [][2]string{
+		{"MIME-Version", "1.0"},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-ID", "<" + messageID + ">"},
+		{"From", m.From.String()},
+		{"To", addressList(m.To)},
+		{"Cc", addressList(m.Cc)},
+		{"Reply-To", addressList(m.ReplyTo)},
+		{"Subject", encodeHeader("Subject", m.Subject)},
+	}
+
+	for _, key := range sortedKeys(m.Header) {
+		for _, value := range m.Header[key] {
+			headers = append(headers, [2]string{key, encodeHeader(key, value)})
+		}
+	}
+
+	for _, key := range sortedKeys(contentHeader) {
+		headers = append(headers, [2]string{key, contentHeader.Get(key)})
+	}
+
+	for _, header := range headers {
+		if header[1] == "" {
+			continue
+		}
+
+		if _, err := io.WriteString(w, foldHeader(header[0], header[1])); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "\r\n")
+
+	return err
+}
+
+// textPart returns a quoted-printable encoded text part
+func textPart(mediaType string, content string) part {
+	return part{
+		header: textproto.MIMEHeader{
+			"Content-Type":              {mediaType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		},
+		write: func(w io.Writer) error {
+			qw := quotedprintable.NewWriter(w)
+
+			if _, err := io.WriteString(qw, content); err != nil {
+				return err
+			}
+
+			return qw.Close()
+		},
+	}
+}
+
+// multipartPart returns a part containing parts
+func multipartPart(mediaType string, params map[string]string, parts ...part) (part, error) {
+	// the boundary is shorter than the one generated by multipart.Writer, as the headers of nested parts are not folded
+	boundary := make([]byte, 15)
+
+	if _, err := rand.Read(boundary); err != nil {
+		return part{}, fmt.Errorf("error generating boundary: %w", err)
+	}
+
+	withBoundary := map[string]string{"boundary": hex.EncodeToString(boundary)}
+
+	for name, value := range params {
+		withBoundary[name] = value
+	}
+
+	return part{
+		header: textproto.MIMEHeader{"Content-Type": {stdmime.FormatMediaType(mediaType, withBoundary)}},
+		write: func(w io.Writer) error {
+			mw := multipart.NewWriter(w)
+
+			if err := mw.SetBoundary(withBoundary["boundary"]); err != nil {
+				return err
+			}
+
+			for _, p := range parts {
+				pw, err := mw.CreatePart(p.header)
+
+				if err != nil {
+					return err
+				}
+
+				if err := p.write(pw); err != nil {
+					return err
+				}
+			}
+
+			return mw.Close()
+		},
+	}, nil
+}
+
+// inlinePart returns a base64 encoded part containing an inline file
+func inlinePart(inline Inline) (part, error) {
+	contentType := inline.ContentType
+
+	if contentType == "" {
+		contentType = http.DetectContentType(inline.Data)
+	}
+
+	mediaType, params, err := stdmime.ParseMediaType(contentType)
+
+	if err != nil {
+		return part{}, fmt.Errorf("invalid content type of %s: %w", inline.ContentID, err)
+	}
+
+	disposition := "inline"
+
+	if inline.Filename != "" {
+		params["name"] = inline.Filename
+		disposition = stdmime.FormatMediaType("inline", map[string]string{"filename": inline.Filename})
+	}
+
+	return part{
+		header: textproto.MIMEHeader{
+			"Content-Type":              {stdmime.FormatMediaType(mediaType, params)},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Id":                {"<" + inline.ContentID + ">"},
+			"Content-Disposition":       {disposition},
+		},
+		write: func(w io.Writer) error {
+			encoded := base64.StdEncoding.EncodeToString(inline.Data)
+
+			for len(encoded) > 0 {
+				n := min(len(encoded), maxLineLength)
+
+				if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+					return err
+				}
+
+				encoded = encoded[n:]
+			}
+
+			return nil
+		},
+	}, nil
+}
+
+func (m *Message) generateMessageID() (string, error) {
+	id := make([]byte, 16)
+
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("error generating Message-ID: %w", err)
+	}
+
+	domain := "localhost"
+
+	if at := strings.LastIndexByte(m.From.Address, '@'); at >= 0 {
+		domain = m.From.Address[at+1:]
+	}
+
+	return hex.EncodeToString(id) + "@" + domain, nil
+}
+
+func addressList(addresses []*mail.Address) string {
+	formatted := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		formatted = append(formatted, address.String())
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// maxEncodedWordLength is the maximum length of an RFC 2047 encoded word
+const maxEncodedWordLength = 75
+
+// encodeHeader Q-encodes the value of the header called name if it is not printable ASCII. The value is split into
+// encoded words short enough for foldHeader to keep every line within maxLineLength, including the first line, which
+// starts with the name of the header.
+func encodeHeader(name string, value string) string {
+	if stdmime.QEncoding.Encode("utf-8", value) == value {
+		return value
+	}
+
+	const prefix, suffix = "=?utf-8?q?", "?="
+
+	var (
+		words []string
+		word  strings.Builder
+	)
+
+	// the first word follows "name: ", and the others can start a continuation line after a space
+	limit := min(maxLineLength-len(name)-2, maxEncodedWordLength)
+
+	for _, r := range value {
+		encoded := qEncodeRune(r)
+
+		if word.Len() > 0 && len(prefix)+word.Len()+len(encoded)+len(suffix) > limit {
+			words = append(words, prefix+word.String()+suffix)
+			word.Reset()
+			limit = min(maxLineLength-1, maxEncodedWordLength)
+		}
+
+		word.WriteString(encoded)
+	}
+
+	words = append(words, prefix+word.String()+suffix)
+
+	return strings.Join(words, " ")
+}
+
+// qEncodeRune encodes a rune using the Q encoding of RFC 2047. Runes are never split across encoded words, which
+// would make them invalid UTF-8.
+func qEncodeRune(r rune) string {
+	var sb strings.Builder
+
+	for _, b := range []byte(string(r)) {
+		switch {
+		case b == ' ':
+			sb.WriteByte('_')
+
+		case b > ' ' && b <= '~' && b != '=' && b != '?' && b != '_':
+			sb.WriteByte(b)
+
+		default:
+			fmt.Fprintf(&sb, "=%02X", b)
+		}
+	}
+
+	return sb.String()
+}
+
+// foldHeader formats a header, folding it at spaces so that lines do not exceed the recommended length where possible
+func foldHeader(name string, value string) string {
+	var sb strings.Builder
+
+	sb.WriteString(name + ":")
+	length := len(name) + 1
+
+	for i, word := range strings.Split(value, " ") {
+		if i > 0 && length+1+len(word) > maxLineLength {
+			sb.WriteString("\r\n")
+			length = 0
+		}
+
+		sb.WriteString(" " + word)
+		length += 1 + len(word)
+	}
+
+	sb.WriteString("\r\n")
+
+	return sb.String()
+}
+
+func sortedKeys(header textproto.MIMEHeader) []string {
+	keys := make([]string, 0, len(header))
+
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
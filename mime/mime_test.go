@@ -0,0 +1,227 @@
+package mime
+
+import (
+	"bytes"
+	"context"
+	"io"
+	stdmime "mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+type testPart struct {
+	header  textproto.MIMEHeader
+	content string
+}
+
+// readParts returns the leaf parts of an entity, decoding quoted-printable parts
+func readParts(t *testing.T, header textproto.MIMEHeader, body io.Reader) []testPart {
+	t.Helper()
+
+	mediaType, params, err := stdmime.ParseMediaType(header.Get("Content-Type"))
+
+	if err != nil {
+		t.Fatalf("Error parsing content type: %s", err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		content, err := io.ReadAll(body)
+
+		if err != nil {
+			t.Fatalf("Error reading part: %s", err)
+		}
+
+		return []testPart{{header: header, content: string(content)}}
+	}
+
+	var parts []testPart
+
+	mr := multipart.NewReader(body, params["boundary"])
+
+	for {
+		p, err := mr.NextPart()
+
+		if err == io.EOF {
+			return parts
+		}
+
+		if err != nil {
+			t.Fatalf("Error reading part: %s", err)
+		}
+
+		parts = append(parts, readParts(t, p.Header, p)...)
+	}
+}
+
+func TestMessage(t *testing.T) {
+
+	result, err := mjml.Compile(context.Background(), `<mjml><mj-body><mj-section><mj-column>
+<mj-image src="cid:logo" alt="Logo" />
+<mj-text>Grüße from a template with a line that is long enough to need a soft line break when it is encoded as quoted-printable</mj-text>
+</mj-column></mj-section></mj-body></mjml>`)
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	msg, err := FromResult(result)
+
+	if err != nil {
+		t.Fatalf("Error creating message: %s", err)
+	}
+
+	msg.From = &mail.Address{Name: "Example Sender", Address: "hello@example.com"}
+	msg.To = []*mail.Address{{Name: "Jörg", Address: "jorg@example.com"}, {Address: "user@example.com"}}
+	msg.Bcc = []*mail.Address{{Address: "audit@example.com"}}
+	msg.Subject = "Willkommen, schön dass du da bist"
+	msg.Date = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg.Header = textproto.MIMEHeader{"X-Campaign": {"welcome"}}
+	msg.Inline = []Inline{{ContentID: "logo", Filename: "logo.png", Data: bytes.Repeat([]byte("\x89PNG\r\n\x1a\n"), 20)}}
+
+	raw, err := msg.Bytes()
+
+	if err != nil {
+		t.Fatalf("Error writing message: %s", err)
+	}
+
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 78 {
+			t.Errorf("Expected lines to be at most 78 characters, got: %q", line)
+		}
+	}
+
+	if strings.Contains(strings.ReplaceAll(string(raw), "\r\n", ""), "\n") {
+		t.Error("Expected lines to end with CRLF")
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+
+	if err != nil {
+		t.Fatalf("Error parsing message: %s", err)
+	}
+
+	subject, err := new(stdmime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+
+	if err != nil || subject != msg.Subject {
+		t.Errorf("Expected subject %q, got %q (%v)", msg.Subject, subject, err)
+	}
+
+	to, err := parsed.Header.AddressList("To")
+
+	if err != nil || len(to) != 2 || to[0].Name != "Jörg" {
+		t.Errorf("Expected the To addresses to be decoded, got %v (%v)", to, err)
+	}
+
+	if parsed.Header.Get("Bcc") != "" || parsed.Header.Get("X-Campaign") != "welcome" || parsed.Header.Get("Date") != "Tue, 02 Jan 2024 03:04:05 +0000" {
+		t.Errorf("Unexpected headers: %v", parsed.Header)
+	}
+
+	if !strings.HasSuffix(parsed.Header.Get("Message-Id"), "@example.com>") {
+		t.Errorf("Expected a generated Message-ID, got: %s", parsed.Header.Get("Message-Id"))
+	}
+
+	if mediaType, _, _ := stdmime.ParseMediaType(parsed.Header.Get("Content-Type")); mediaType != "multipart/related" {
+		t.Errorf("Expected a multipart/related message, got: %s", mediaType)
+	}
+
+	parts := readParts(t, textproto.MIMEHeader(parsed.Header), parsed.Body)
+
+	if len(parts) != 3 {
+		t.Fatalf("Expected text, html and image parts, got %d parts", len(parts))
+	}
+
+	// multipart.Reader decodes quoted-printable parts, which have CRLF line endings
+	if strings.ReplaceAll(parts[0].content, "\r\n", "\n") != msg.Text || strings.ReplaceAll(parts[1].content, "\r\n", "\n") != msg.HTML {
+		t.Error("Expected the text and html parts to match the message")
+	}
+
+	if parts[2].header.Get("Content-Id") != "<logo>" || parts[2].header.Get("Content-Type") != `image/png; name=logo.png` {
+		t.Errorf("Unexpected headers of inline image: %v", parts[2].header)
+	}
+
+	if recipients := strings.Join(msg.Recipients(), ","); recipients != "jorg@example.com,user@example.com,audit@example.com" {
+		t.Errorf("Unexpected recipients: %s", recipients)
+	}
+
+	if _, err := (&Message{HTML: "<p>Hello</p>"}).Bytes(); err == nil {
+		t.Error("Expected an error for a message without a From address")
+	}
+}
+
+func TestLongSubject(t *testing.T) {
+
+	msg := &Message{
+		From:    &mail.Address{Address: "hello@example.com"},
+		To:      []*mail.Address{{Address: "user@example.com"}},
+		Subject: strings.Repeat("Grüße aus München, schön dass du da bist! ", 5),
+		Header:  textproto.MIMEHeader{"X-Campaign-Description": {strings.Repeat("Ünïcödé ", 20)}},
+		Text:    "Hello",
+	}
+
+	raw, err := msg.Bytes()
+
+	if err != nil {
+		t.Fatalf("Error writing message: %s", err)
+	}
+
+	headers, _, _ := strings.Cut(string(raw), "\r\n\r\n")
+
+	for _, line := range strings.Split(headers, "\r\n") {
+		if len(line) > 78 {
+			t.Errorf("Expected lines to be at most 78 characters, got %d: %q", len(line), line)
+		}
+
+		for _, word := range strings.Fields(line) {
+			if strings.HasPrefix(word, "=?") && len(word) > 75 {
+				t.Errorf("Expected encoded words to be at most 75 characters, got: %q", word)
+			}
+		}
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+
+	if err != nil {
+		t.Fatalf("Error parsing message: %s", err)
+	}
+
+	decoder := new(stdmime.WordDecoder)
+
+	if subject, err := decoder.DecodeHeader(parsed.Header.Get("Subject")); err != nil || subject != msg.Subject {
+		t.Errorf("Expected subject %q, got %q (%v)", msg.Subject, subject, err)
+	}
+
+	description := msg.Header.Get("X-Campaign-Description")
+
+	if decoded, err := decoder.DecodeHeader(parsed.Header.Get("X-Campaign-Description")); err != nil || decoded != description {
+		t.Errorf("Expected header %q, got %q (%v)", description, decoded, err)
+	}
+}
+
+func TestFromResultImages(t *testing.T) {
+
+	images := mjml.MapImageLoader(map[string][]byte{"logo.png": []byte("\x89PNG\r\n\x1a\nlogo")})
+
+	result, err := mjml.Compile(context.Background(), `<mjml><mj-body><mj-section><mj-column>
+<mj-image src="logo.png" alt="Logo" />
+</mj-column></mj-section></mj-body></mjml>`, mjml.WithInlineImages(images))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	msg, err := FromResult(result)
+
+	if err != nil {
+		t.Fatalf("Error creating message: %s", err)
+	}
+
+	if len(msg.Inline) != 1 || msg.Inline[0].ContentID != result.Images[0].ContentID || msg.Inline[0].Filename != "logo.png" {
+		t.Errorf("Expected the images of the result to be inline files, got: %v", msg.Inline)
+	}
+}
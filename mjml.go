@@ -40,6 +40,8 @@ func init() {
 		panic(fmt.Sprintf("Error decompressing wasm file: %s", err))
 	}
 
+	componentsSupported = bytes.Contains(decompressed, []byte(componentsMarker))
+
 	runtime = wazero.NewRuntime(ctx) // TODO: this should be closed
 
 	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
@@ -89,31 +91,67 @@ type jsonResult struct {
 
 // ToHTML converts a string containing mjml to HTML while using any of the optionally provided options
 func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (string, error) {
-	data := map[string]interface{}{
-		"mjml": mjml,
-	}
+	result, err := Compile(ctx, mjml, toHTMLOptions...)
 
-	o := options{
-		data: map[string]interface{}{},
+	if err != nil {
+		return "", err
 	}
 
+	return result.HTML, nil
+}
+
+// Compile converts a string containing mjml to HTML like ToHTML, returning a Result which can also be converted to
+// plain text
+func Compile(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (*Result, error) {
+	o := newOptions()
+
 	for _, opt := range toHTMLOptions {
 		opt(o)
 	}
 
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	o.preserveMediaQueries()
+
+	mjml, err := o.local.preprocess(ctx, mjml)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mjml, lineMap, images, err := o.local.transform(ctx, mjml)
+
+	if err != nil {
+		return nil, fmt.Errorf("error applying options to mjml: %w", err)
+	}
+
+	data := map[string]interface{}{
+		"mjml": mjml,
+	}
+
 	if len(o.data) > 0 {
 		data["options"] = o.data
 	}
 
+	if components := registeredComponents(); len(components) > 0 {
+		if !componentsSupported {
+			return nil, ErrComponentsNotSupported
+		}
+
+		data["components"] = components
+	}
+
 	inputBytes := bytes.NewBuffer([]byte{})
 
 	encoder := json.NewEncoder(inputBytes)
 	encoder.SetEscapeHTML(false)
 
-	err := encoder.Encode(data)
+	err = encoder.Encode(data)
 
 	if err != nil {
-		return "", fmt.Errorf("error encoding input data: %w", err)
+		return nil, fmt.Errorf("error encoding input data: %w", err)
 	}
 
 	jsonInput := inputBytes.String()
@@ -134,7 +172,7 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 		if err != nil {
 
 			if tries >= 30 {
-				return "", fmt.Errorf("unable to accquire wasm module after 30 tries: %w", err)
+				return nil, fmt.Errorf("unable to accquire wasm module after 30 tries: %w", err)
 			}
 
 			if err == puddle.ErrClosedPool {
@@ -142,7 +180,7 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 				continue
 			}
 
-			return "", fmt.Errorf("error accquiring wasm module: %w", err)
+			return nil, fmt.Errorf("error accquiring wasm module: %w", err)
 		}
 
 		break
@@ -153,7 +191,7 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 	mod, ok := module.Value().(api.Module)
 
 	if !ok {
-		return "", errors.New("pool resource is not an api.Module")
+		return nil, errors.New("pool resource is not an api.Module")
 	}
 
 	deallocate := mod.ExportedFunction("deallocate")
@@ -164,11 +202,11 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 	allocation, err := allocate.Call(ctx, jsonInputLen)
 
 	if err != nil {
-		return "", fmt.Errorf("error allocating memory: %w", err)
+		return nil, fmt.Errorf("error allocating memory: %w", err)
 	}
 
 	if len(allocation) != 1 {
-		return "", errors.New("invalid input pointer allocated")
+		return nil, errors.New("invalid input pointer allocated")
 	}
 
 	inputPtr := allocation[0]
@@ -176,13 +214,13 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 	defer deallocate.Call(ctx, inputPtr)
 
 	if !memory.Write(uint32(inputPtr), []byte(jsonInput)) {
-		return "", fmt.Errorf("error writing input to memory: %w", err)
+		return nil, fmt.Errorf("error writing input to memory: %w", err)
 	}
 
 	ident, err := randomIdentifier()
 
 	if err != nil {
-		return "", fmt.Errorf("error generating identifier: %w", err)
+		return nil, fmt.Errorf("error generating identifier: %w", err)
 	}
 
 	resultCh := make(chan []byte, 1)
@@ -194,7 +232,7 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 	_, err = run.Call(ctx, inputPtr, jsonInputLen, uint64(ident))
 
 	if err != nil {
-		return "", fmt.Errorf("error calling run: %w", err)
+		return nil, fmt.Errorf("error calling run: %w", err)
 	}
 
 	result := <-resultCh
@@ -204,14 +242,42 @@ func ToHTML(ctx context.Context, mjml string, toHTMLOptions ...ToHTMLOption) (st
 	err = json.Unmarshal(result, &res)
 
 	if err != nil {
-		return "", fmt.Errorf("error decoding result json: %w", err)
+		return nil, fmt.Errorf("error decoding result json: %w", err)
 	}
 
 	if res.Error != nil {
-		return "", *res.Error
+		res.Error.mapLines(lineMap)
+		res.Error.HTML = res.HTML
+
+		// the validation errors are returned even if postprocessing the html compiled despite them fails
+		if html, err := o.local.postprocess(ctx, res.HTML); err == nil {
+			res.Error.HTML = html
+		}
+
+		return nil, *res.Error
+	}
+
+	html, err := o.local.postprocess(ctx, res.HTML)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sizeReport, err := o.local.checkSize(html)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return res.HTML, nil
+	return &Result{
+		HTML:       html,
+		Images:     images,
+		Size:       len(html),
+		SizeReport: sizeReport,
+		mjml:       mjml,
+		lineMap:    lineMap,
+		textWidth:  o.local.textWidth,
+	}, nil
 }
 
 func registerHostFunctions(ctx context.Context, r wazero.Runtime) error {
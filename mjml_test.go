@@ -3,9 +3,11 @@ package mjml
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -47,6 +49,23 @@ func TestToHTML(t *testing.T) {
 	}
 }
 
+func TestToHTMLSoftValidationErrors(t *testing.T) {
+
+	input := `<mjml><mj-body><mj-section><mj-column><mj-text color="not-a-color">Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+	_, err := ToHTML(context.Background(), input, WithValidationLevel(Soft))
+
+	var mjmlError Error
+
+	if !errors.As(err, &mjmlError) {
+		t.Fatalf("Expected an mjml error, got: %v", err)
+	}
+
+	if !strings.Contains(mjmlError.HTML, "Hello World") {
+		t.Errorf("Expected the error to contain the compiled html: %s", mjmlError.HTML)
+	}
+}
+
 func TestConcurrency(t *testing.T) {
 
 	files := []string{
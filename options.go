@@ -1,6 +1,10 @@
 package mjml
 
-import "fmt"
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
 
 type ValidationLevel string
 
@@ -16,7 +20,15 @@ type JuiceTag struct {
 }
 
 type options struct {
-	data map[string]interface{}
+	data  map[string]interface{}
+	local *localOptions
+}
+
+func newOptions() options {
+	return options{
+		data:  map[string]interface{}{},
+		local: &localOptions{textWidth: DefaultTextWidth},
+	}
 }
 
 type Fonts map[string]string
@@ -44,6 +56,8 @@ func WithBeautifyOptions(bOptions BeautifyOptions) ToHTMLOption {
 }
 
 func WithFonts(fonts Fonts) ToHTMLOption {
+	fonts = maps.Clone(fonts)
+
 	return func(o options) {
 		o.data["fonts"] = fonts
 	}
@@ -62,6 +76,8 @@ func WithJuiceOptions(jOptions JuiceOptions) ToHTMLOption {
 }
 
 func WithJuicePreserveTags(preserveTags map[string]JuiceTag) ToHTMLOption {
+	preserveTags = maps.Clone(preserveTags)
+
 	return func(o options) {
 		o.data["juicePreserveTags"] = preserveTags
 	}
@@ -91,12 +107,28 @@ func WithMinifyOptions(minifyOptions HTMLMinifierOptions) ToHTMLOption {
 	}
 }
 
+// Deprecated: mjml expects preprocessors to be JavaScript functions, which cannot be passed as strings, so compiling
+// with a non-empty list returns an OptionError. Use WithPreprocessor instead.
 func WithPreprocessors(preprocessors []string) ToHTMLOption {
+	preprocessors = slices.Clone(preprocessors)
+
 	return func(o options) {
 		o.data["preprocessors"] = preprocessors
 	}
 }
 
+// WithRawOptions passes options to mjml as they are, using the names and JSON values documented by MJML, replacing
+// any options with the same names
+func WithRawOptions(rawOptions map[string]interface{}) ToHTMLOption {
+	rawOptions = maps.Clone(rawOptions)
+
+	return func(o options) {
+		for name, value := range rawOptions {
+			o.data[name] = value
+		}
+	}
+}
+
 func WithValidationLevel(validationLevel ValidationLevel) ToHTMLOption {
 	return func(o options) {
 		o.data["validationLevel"] = validationLevel
@@ -68,3 +68,26 @@ func TestOptions(t *testing.T) {
 		t.Error("Options does not match expected data")
 	}
 }
+
+func TestRawOptions(t *testing.T) {
+
+	rawOptions := map[string]interface{}{"keepComments": false, "filePath": "template.mjml"}
+
+	o := options{data: map[string]interface{}{}}
+
+	for _, f := range []ToHTMLOption{WithKeepComments(true), WithMinify(true), WithRawOptions(rawOptions)} {
+		f(o)
+	}
+
+	expected := map[string]interface{}{"keepComments": false, "minify": true, "filePath": "template.mjml"}
+
+	if !reflect.DeepEqual(o.data, expected) {
+		t.Errorf("Expected raw options to replace earlier options, got %v", o.data)
+	}
+
+	WithKeepComments(true)(o)
+
+	if o.data["keepComments"] != true {
+		t.Error("Expected later options to replace raw options")
+	}
+}
@@ -0,0 +1,147 @@
+package mjml
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// Options is an immutable set of options, which can be composed with other options, compared and inspected. Use
+// WithOptions to compile templates using them.
+type Options struct {
+	toHTMLOptions []ToHTMLOption
+}
+
+// NewOptions returns the set of the given options
+func NewOptions(toHTMLOptions ...ToHTMLOption) Options {
+	return Options{toHTMLOptions: slices.Clone(toHTMLOptions)}
+}
+
+// With returns a new set of options, where the given options replace the ones of o with the same names
+func (o Options) With(toHTMLOptions ...ToHTMLOption) Options {
+	return Options{toHTMLOptions: slices.Concat(o.toHTMLOptions, toHTMLOptions)}
+}
+
+// Merge returns a new set of options, where the options of other replace the ones of o with the same names
+func (o Options) Merge(other Options) Options {
+	return o.With(other.toHTMLOptions...)
+}
+
+// Beautify reports whether the output is beautified
+func (o Options) Beautify() bool {
+	beautify, _ := o.resolve().data["beautify"].(bool)
+	return beautify
+}
+
+// Minify reports whether the output is minified
+func (o Options) Minify() bool {
+	minify, _ := o.resolve().data["minify"].(bool)
+	return minify
+}
+
+// KeepComments reports whether comments are kept in the output, which mjml does by default
+func (o Options) KeepComments() bool {
+	keepComments, ok := o.resolve().data["keepComments"].(bool)
+	return keepComments || !ok
+}
+
+// ValidationLevel returns the validation level, which is Soft unless it is set. Levels set as strings using
+// WithRawOptions are also returned.
+func (o Options) ValidationLevel() ValidationLevel {
+	switch level := o.resolve().data["validationLevel"].(type) {
+	case ValidationLevel:
+		return level
+	case string:
+		return ValidationLevel(level)
+	}
+
+	return Soft
+}
+
+// Fonts returns a copy of the fonts set using WithFonts
+func (o Options) Fonts() Fonts {
+	fonts, _ := o.resolve().data["fonts"].(Fonts)
+	return maps.Clone(fonts)
+}
+
+// JSON returns the options passed to mjml, using the names and JSON values documented by MJML. Options applied in Go,
+// such as themes, includes and components, are not included.
+func (o Options) JSON() ([]byte, error) {
+	return json.Marshal(o.resolve().data)
+}
+
+// Equal reports whether o and other result in the same compilation. Functions cannot be compared, so options setting
+// preprocessors, postprocessors, an image loader, a link rewriter or components are never equal to other options.
+// Include file systems are compared by value.
+func (o Options) Equal(other Options) bool {
+	a, b := o.resolve(), other.resolve()
+	return reflect.DeepEqual(a.data, b.data) && a.local.equal(b.local)
+}
+
+func (o Options) resolve() options {
+	resolved := newOptions()
+
+	for _, opt := range o.toHTMLOptions {
+		opt(resolved)
+	}
+
+	return resolved
+}
+
+// WithOptions applies a set of options
+func WithOptions(o Options) ToHTMLOption {
+	return func(opts options) {
+		for _, opt := range o.toHTMLOptions {
+			opt(opts)
+		}
+	}
+}
+
+var (
+	// PresetMJMLCLIDefaults uses the defaults of the MJML CLI, which beautifies the output
+	PresetMJMLCLIDefaults = NewOptions(
+		WithBeautify(true),
+		WithBeautifyOptions(cliBeautifyOptions()),
+		WithMinify(false),
+		WithKeepComments(true),
+		WithValidationLevel(Soft),
+	)
+
+	// PresetProductionMinified minifies the output without comments and rejects invalid templates
+	PresetProductionMinified = NewOptions(
+		WithBeautify(false),
+		WithMinify(true),
+		WithMinifyOptions(cliMinifyOptions().RemoveComments(true)),
+		WithKeepComments(false),
+		WithValidationLevel(Strict),
+	)
+
+	// PresetDebugBeautified beautifies the output with each attribute on its own line, keeping comments and returning
+	// the output along with validation errors
+	PresetDebugBeautified = NewOptions(
+		WithBeautify(true),
+		WithBeautifyOptions(cliBeautifyOptions().WrapAttributes(BeautifyWrapAttributesForceExpandMultiline)),
+		WithMinify(false),
+		WithKeepComments(true),
+		WithValidationLevel(Soft),
+	)
+)
+
+// cliBeautifyOptions returns the js-beautify options used by the MJML CLI
+func cliBeautifyOptions() BeautifyOptions {
+	return NewBeautifyOptions().
+		EndWithNewline(true).
+		IndentSize(2).
+		PreserveNewlines(false).
+		WrapAttributesIndentSize(2)
+}
+
+// cliMinifyOptions returns the html-minifier options used by the MJML CLI
+func cliMinifyOptions() HTMLMinifierOptions {
+	return NewHTMLMinifierOptions().
+		CaseSensitive(true).
+		CollapseWhitespace(true).
+		MinifyCSS(false).
+		RemoveEmptyAttributes(true)
+}
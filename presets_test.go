@@ -0,0 +1,158 @@
+package mjml
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildersDoNotShareData(t *testing.T) {
+
+	base := NewJuiceOptions().PreserveKeyFrames(true)
+	withPseudos := base.PreservePseudos(true)
+	withFontFaces := base.PreserveFontFaces(true)
+
+	if data := base.(*juiceOptions).data; len(data) != 1 {
+		t.Errorf("Expected base options to be unchanged, got: %v", data)
+	}
+
+	if _, ok := withFontFaces.(*juiceOptions).data["preservePseudos"]; ok {
+		t.Error("Expected options derived from the same options not to share data")
+	}
+
+	if _, ok := withPseudos.(*juiceOptions).data["preserveFontFaces"]; ok {
+		t.Error("Expected options derived from the same options not to share data")
+	}
+
+	theme := Theme{
+		Fonts:      Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"},
+		All:        map[string]string{"padding": "0px"},
+		Components: map[string]map[string]string{"mj-button": {"color": "#ffffff"}},
+		Classes:    map[string]map[string]string{"blue": {"color": "#0000ff"}},
+	}
+
+	htmlAttributes := map[string]string{"data-id": "42"}
+	palette := DarkPalette{"#ffffff": "#000000"}
+
+	set := NewOptions(WithTheme(theme), WithHTMLAttributes(".cta", htmlAttributes), WithDarkMode(palette))
+
+	theme.Fonts["Raleway"] = "changed"
+	theme.All["padding"] = "changed"
+	theme.Components["mj-button"]["color"] = "changed"
+	theme.Classes["blue"]["color"] = "changed"
+	htmlAttributes["data-id"] = "changed"
+	palette["#ffffff"] = "changed"
+
+	local := set.resolve().local
+	resolved := local.themes[0]
+
+	if resolved.Fonts["Raleway"] == "changed" || resolved.All["padding"] == "changed" ||
+		resolved.Components["mj-button"]["color"] == "changed" || resolved.Classes["blue"]["color"] == "changed" {
+		t.Errorf("Expected options not to change when the theme they were created from changes, got: %+v", resolved)
+	}
+
+	if local.head.htmlAttributes[0].attributes["data-id"] == "changed" {
+		t.Error("Expected options not to change when the html attributes they were created from change")
+	}
+
+	if local.darkPalette["#ffffff"] == "changed" {
+		t.Error("Expected options not to change when the palette they were created from changes")
+	}
+}
+
+func TestOptionsSet(t *testing.T) {
+
+	fonts := Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"}
+
+	base := NewOptions(WithMinify(true), WithFonts(fonts))
+	derived := base.With(WithMinify(false), WithValidationLevel(Strict))
+
+	fonts["Raleway"] = "changed"
+
+	if !base.Minify() || derived.Minify() {
+		t.Error("Expected With not to modify the original options")
+	}
+
+	if base.Fonts()["Raleway"] != "https://fonts.googleapis.com/css?family=Raleway" {
+		t.Error("Expected options not to change when the fonts they were created from change")
+	}
+
+	if base.ValidationLevel() != Soft || derived.ValidationLevel() != Strict {
+		t.Errorf("Expected validation levels soft and strict, got %s and %s", base.ValidationLevel(), derived.ValidationLevel())
+	}
+
+	if !base.KeepComments() || base.Beautify() {
+		t.Error("Expected mjml defaults for options that are not set")
+	}
+
+	merged := PresetProductionMinified.Merge(NewOptions(WithKeepComments(true)))
+
+	if !merged.Minify() || !merged.KeepComments() || PresetProductionMinified.KeepComments() {
+		t.Error("Expected merged options to replace the options of the preset without modifying it")
+	}
+
+	if !NewOptions(WithMinify(true), WithValidationLevel(Skip)).Equal(NewOptions(WithValidationLevel(Skip)).With(WithMinify(true))) {
+		t.Error("Expected options with the same values to be equal")
+	}
+
+	if PresetMJMLCLIDefaults.Equal(PresetDebugBeautified) {
+		t.Error("Expected different presets not to be equal")
+	}
+
+	if NewOptions(WithTitle("A")).Equal(NewOptions(WithTitle("B"))) {
+		t.Error("Expected options applied in Go to be compared")
+	}
+
+	if !NewOptions(WithDarkMode(DarkPalette{"#ffffff": "#000000"})).Equal(NewOptions(WithDarkMode(DarkPalette{"#ffffff": "#000000"}))) {
+		t.Error("Expected options applied in Go with the same values to be equal")
+	}
+
+	rewriter := WithLinkRewriter(func(link LinkInfo) string { return link.URL })
+
+	if NewOptions(rewriter).Equal(NewOptions(rewriter)) {
+		t.Error("Expected options setting functions not to be equal")
+	}
+
+	if level := NewOptions(WithRawOptions(map[string]interface{}{"validationLevel": "strict"})).ValidationLevel(); level != Strict {
+		t.Errorf("Expected the validation level set as a string to be strict, got %s", level)
+	}
+
+	json, err := NewOptions(WithMinify(true), WithMinifyOptions(NewHTMLMinifierOptions().HTML5(true))).JSON()
+
+	if err != nil {
+		t.Fatalf("Error marshaling options: %s", err)
+	}
+
+	if string(json) != `{"minify":true,"minifyOptions":{"html5":true}}` {
+		t.Errorf("Unexpected JSON: %s", json)
+	}
+}
+
+func TestPresets(t *testing.T) {
+
+	input := `<mjml><mj-body><mj-section><mj-column><mj-text>Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+	minified, err := ToHTML(context.Background(), input, WithOptions(PresetProductionMinified))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	beautified, err := ToHTML(context.Background(), input, WithOptions(PresetDebugBeautified))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if strings.Count(minified, "\n") >= strings.Count(beautified, "\n") {
+		t.Error("Expected the minified output to have fewer lines than the beautified output")
+	}
+
+	o := newOptions()
+	WithOptions(PresetMJMLCLIDefaults)(o)
+
+	if !reflect.DeepEqual(o.data, PresetMJMLCLIDefaults.resolve().data) {
+		t.Error("Expected WithOptions to apply all options of the set")
+	}
+}
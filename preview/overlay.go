@@ -0,0 +1,134 @@
+package preview
+
+import (
+	"errors"
+	"html/template"
+	"strings"
+
+	"github.com/Boostport/mjml-go"
+	"github.com/Boostport/mjml-go/ast"
+	"github.com/Boostport/mjml-go/internal/errorlines"
+)
+
+// snippetContext is the number of lines shown before and after the line of an error
+const snippetContext = 2
+
+var scriptTemplate = template.Must(template.New("script").Parse(
+	`<script>new EventSource("` + eventsPath + `?template=" + encodeURIComponent({{.}})).addEventListener("reload", function () { location.reload(); });</script>`,
+))
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!doctype html>
+<html>
+<head><meta charset="utf-8"><title>MJML templates</title></head>
+<body style="font-family: sans-serif;">
+<h1>MJML templates</h1>
+<ul>
+{{range .}}<li><a href="/{{.}}">{{.}}</a> (<a href="/{{.}}?minify=true">minified</a>, <a href="/{{.}}?beautify=true">beautified</a>)</li>
+{{else}}<li>No templates found</li>
+{{end}}</ul>
+</body>
+</html>
+`))
+
+var overlayTemplate = template.Must(template.New("overlay").Parse(`<!doctype html>
+<html>
+<head><meta charset="utf-8"><title>Error compiling {{.Name}}</title></head>
+<body style="margin: 0; padding: 24px; background: #1e1e1e; color: #f0f0f0; font-family: sans-serif;">
+<h1 style="color: #ff6b6b; font-size: 20px;">Error compiling {{.Name}}</h1>
+<pre style="white-space: pre-wrap;">{{.Message}}</pre>
+{{range .Problems}}<h2 style="font-size: 16px;">Line {{.Line}}{{if .TagName}} of ({{.TagName}}){{end}}{{if .Message}}: {{.Message}}{{end}}</h2>
+<pre style="background: #2d2d2d; padding: 12px; overflow-x: auto;">{{range .Snippet}}<span{{if .Highlight}} style="background: #5c2b2b; display: block;"{{end}}>{{printf "%4d" .Number}} | {{.Text}}</span>
+{{end}}</pre>
+{{end}}{{.Script}}
+</body>
+</html>
+`))
+
+type overlay struct {
+	Name     string
+	Message  string
+	Problems []problem
+	Script   template.HTML
+}
+
+type problem struct {
+	Line    int
+	TagName string
+	Message string
+	Snippet []snippetLine
+}
+
+type snippetLine struct {
+	Number    int
+	Text      string
+	Highlight bool
+}
+
+// newOverlay describes a compilation error of the template called name, showing the lines of source it refers to
+func newOverlay(name string, source string, err error, script template.HTML) overlay {
+	o := overlay{
+		Name:    name,
+		Message: err.Error(),
+		Script:  script,
+	}
+
+	lines := strings.Split(source, "\n")
+
+	var mjmlError mjml.Error
+	var syntaxError *ast.SyntaxError
+
+	switch {
+	case errors.As(err, &mjmlError) && len(mjmlError.Details) > 0:
+		for _, detail := range mjmlError.Details {
+			o.Problems = append(o.Problems, problem{
+				Line:    detail.Line,
+				TagName: detail.TagName,
+				Message: detail.Message,
+				Snippet: snippet(lines, detail.Line),
+			})
+		}
+
+	case errors.As(err, &syntaxError):
+		o.Problems = append(o.Problems, problem{
+			Line:    syntaxError.Line,
+			Message: syntaxError.Message,
+			Snippet: snippet(lines, syntaxError.Line),
+		})
+
+	default:
+		for _, line := range errorlines.Parse(err.Error()) {
+			o.Problems = append(o.Problems, problem{
+				Line:    line,
+				Snippet: snippet(lines, line),
+			})
+		}
+	}
+
+	return o
+}
+
+// snippet returns the lines of source surrounding line, which is highlighted
+func snippet(lines []string, line int) []snippetLine {
+	var s []snippetLine
+
+	for number := max(line-snippetContext, 1); number <= min(line+snippetContext, len(lines)); number++ {
+		s = append(s, snippetLine{
+			Number:    number,
+			Text:      lines[number-1],
+			Highlight: number == line,
+		})
+	}
+
+	return s
+}
+
+// reloadScript returns the script that reloads the page when the template called name changes
+func reloadScript(name string) (template.HTML, error) {
+	var sb strings.Builder
+
+	if err := scriptTemplate.Execute(&sb, name); err != nil {
+		return "", err
+	}
+
+	return template.HTML(sb.String()), nil
+}
@@ -0,0 +1,267 @@
+// Package preview serves a directory of MJML templates as HTML for previewing them in a browser while they are being
+// developed. Pages reload automatically when their template or the files it includes change, and compilation errors
+// are displayed in the page along with the lines of the template they refer to.
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+const eventsPath = "/_mjml/events"
+
+// Handler is an http.Handler that compiles the template at the request path using mjml.ToHTML, so previews match
+// the output of the library. For example, /emails/welcome serves emails/welcome.mjml. The root path lists the
+// available templates.
+//
+// The minify and beautify query parameters toggle the corresponding options, for example /welcome?minify=true.
+type Handler struct {
+	// PollInterval is how often templates and the files they include are checked for changes. Defaults to 500ms.
+	PollInterval time.Duration
+
+	fsys    fs.FS
+	options []mjml.ToHTMLOption
+}
+
+// New returns a Handler serving the templates in fsys, which are compiled using options. Templates can include
+// files from anywhere in fsys.
+func New(fsys fs.FS, options ...mjml.ToHTMLOption) *Handler {
+	return &Handler{
+		fsys:    fsys,
+		options: options,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/":
+		h.serveIndex(w)
+
+	case eventsPath:
+		h.serveEvents(w, r)
+
+	default:
+		h.serveTemplate(w, r)
+	}
+}
+
+func (h *Handler) serveIndex(w http.ResponseWriter) {
+	var templates []string
+
+	err := fs.WalkDir(h.fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && path.Ext(p) == ".mjml" {
+			templates = append(templates, strings.TrimSuffix(p, ".mjml"))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error listing templates: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := indexTemplate.Execute(w, templates); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) serveTemplate(w http.ResponseWriter, r *http.Request) {
+	name := templateName(r.URL.Path)
+
+	if !fs.ValidPath(name) {
+		http.NotFound(w, r)
+		return
+	}
+
+	source, err := fs.ReadFile(h.fsys, name)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error reading %s: %s", name, err), http.StatusInternalServerError)
+		return
+	}
+
+	options, err := h.requestOptions(r, name)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+
+	script, err := reloadScript(name)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output, err := mjml.ToHTML(r.Context(), string(source), options...)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		if err := overlayTemplate.Execute(w, newOverlay(name, string(source), err, script)); err != nil {
+			fmt.Fprint(w, err)
+		}
+
+		return
+	}
+
+	fmt.Fprint(w, injectScript(output, string(script)))
+}
+
+// requestOptions returns the options used to compile the template called name for the request
+func (h *Handler) requestOptions(r *http.Request, name string) ([]mjml.ToHTMLOption, error) {
+	options := slices.Concat(h.options, []mjml.ToHTMLOption{mjml.WithIncludeFS(h.fsys), mjml.WithFilePath(name)})
+
+	query := r.URL.Query()
+
+	for param, option := range map[string]func(bool) mjml.ToHTMLOption{
+		"beautify": mjml.WithBeautify,
+		"minify":   mjml.WithMinify,
+	} {
+		if !query.Has(param) {
+			continue
+		}
+
+		value, err := strconv.ParseBool(query.Get(param))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %s", param, query.Get(param))
+		}
+
+		options = append(options, option(value))
+	}
+
+	return options, nil
+}
+
+// serveEvents streams a reload event once the template in the query or the files it includes change
+func (h *Handler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("template")
+
+	if !fs.ValidPath(name) {
+		http.Error(w, "invalid template", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	files := []string{name}
+
+	if included, err := mjml.IncludedFiles(h.fsys, name); err == nil {
+		files = append(files, included...)
+	}
+
+	states := h.states(files)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	if err := h.waitForChange(r.Context(), files, states); err != nil {
+		return
+	}
+
+	fmt.Fprint(w, "event: reload\ndata: reload\n\n")
+	flusher.Flush()
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// waitForChange blocks until the states of files differ from states, or ctx is done
+func (h *Handler) waitForChange(ctx context.Context, files []string, states []fileState) error {
+	interval := h.PollInterval
+
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ticker.C:
+			if !slices.Equal(states, h.states(files)) {
+				return nil
+			}
+		}
+	}
+}
+
+func (h *Handler) states(files []string) []fileState {
+	states := make([]fileState, len(files))
+
+	for i, file := range files {
+		if info, err := fs.Stat(h.fsys, file); err == nil {
+			states[i] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	return states
+}
+
+// templateName returns the path of the template for a request path, adding the .mjml extension if it is missing
+func templateName(requestPath string) string {
+	name := strings.TrimPrefix(path.Clean(requestPath), "/")
+
+	if path.Ext(name) != ".mjml" {
+		name += ".mjml"
+	}
+
+	return name
+}
+
+// injectScript adds the live reload script to the end of the body of the compiled html
+func injectScript(html string, script string) string {
+	i := strings.LastIndex(html, "</body>")
+
+	if i < 0 {
+		return html + script
+	}
+
+	return html[:i] + script + html[i:]
+}
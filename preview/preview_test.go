@@ -0,0 +1,171 @@
+package preview
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+var templates = fstest.MapFS{
+	"welcome.mjml": {Data: []byte(`<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-include path="./partials/greeting.mjml" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`)},
+	"partials/greeting.mjml": {Data: []byte(`<mj-text>Hello from a preview</mj-text>`)},
+	"broken.mjml": {Data: []byte(`<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text color="not-a-color">Hello</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`)},
+}
+
+func get(t *testing.T, server *httptest.Server, path string) (int, string) {
+	t.Helper()
+
+	resp, err := http.Get(server.URL + path)
+
+	if err != nil {
+		t.Fatalf("Error requesting %s: %s", path, err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Fatalf("Error reading response for %s: %s", path, err)
+	}
+
+	return resp.StatusCode, string(body)
+}
+
+func TestHandler(t *testing.T) {
+
+	server := httptest.NewServer(New(templates))
+	defer server.Close()
+
+	status, body := get(t, server, "/welcome")
+
+	if status != http.StatusOK || !strings.Contains(body, "Hello from a preview") {
+		t.Errorf("Expected the compiled template, got %d: %s", status, body)
+	}
+
+	if !strings.Contains(body, `new EventSource("/_mjml/events?template=" + encodeURIComponent("welcome.mjml"))`) {
+		t.Errorf("Expected the live reload script to be injected: %s", body)
+	}
+
+	if _, minified := get(t, server, "/welcome.mjml?minify=true"); len(minified) >= len(body) {
+		t.Errorf("Expected the minified output to be shorter than the default output")
+	}
+
+	if status, _ := get(t, server, "/welcome?minify=maybe"); status != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an invalid query parameter, got %d", http.StatusBadRequest, status)
+	}
+
+	if status, _ := get(t, server, "/missing"); status != http.StatusNotFound {
+		t.Errorf("Expected status %d for a missing template, got %d", http.StatusNotFound, status)
+	}
+
+	status, body = get(t, server, "/")
+
+	if status != http.StatusOK || !strings.Contains(body, `<a href="/welcome">welcome</a>`) || !strings.Contains(body, `<a href="/partials/greeting">`) {
+		t.Errorf("Expected the index to list the templates, got %d: %s", status, body)
+	}
+}
+
+func TestHandlerErrorOverlay(t *testing.T) {
+
+	server := httptest.NewServer(New(templates))
+	defer server.Close()
+
+	status, body := get(t, server, "/broken")
+
+	if status != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, status)
+	}
+
+	for _, expected := range []string{
+		"Error compiling broken.mjml",
+		"Line 5 of (mj-text)",
+		`   5 |         &lt;mj-text color=&#34;not-a-color&#34;&gt;Hello&lt;/mj-text&gt;`,
+		"   3 |     &lt;mj-section&gt;",
+		"EventSource",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected the overlay to contain %q: %s", expected, body)
+		}
+	}
+}
+
+func TestHandlerLiveReload(t *testing.T) {
+
+	dir := t.TempDir()
+
+	for name, file := range templates {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755); err != nil {
+			t.Fatalf("Error creating directory: %s", err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, name), file.Data, 0644); err != nil {
+			t.Fatalf("Error writing %s: %s", name, err)
+		}
+	}
+
+	handler := New(os.DirFS(dir))
+	handler.PollInterval = 10 * time.Millisecond
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/_mjml/events?template=welcome.mjml", nil)
+
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		t.Fatalf("Error requesting events: %s", err)
+	}
+
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("Expected an event stream, got %s", contentType)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "partials", "greeting.mjml"), []byte(`<mj-text>Changed greeting</mj-text>`), 0644); err != nil {
+		t.Fatalf("Error changing partial: %s", err)
+	}
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+
+	if err != nil {
+		t.Fatalf("Error reading event: %s", err)
+	}
+
+	if line != "event: reload\n" {
+		t.Errorf("Expected a reload event, got %q", line)
+	}
+}
@@ -0,0 +1,64 @@
+package mjml
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithPreprocessor adds a function that transforms the mjml before it is compiled, for example to render a template
+// language. Preprocessors run in the order they are added, before includes, components and themes are applied.
+func WithPreprocessor(preprocessor func(ctx context.Context, mjml string) (string, error)) ToHTMLOption {
+	return func(o options) {
+		o.local.preprocessors = append(o.local.preprocessors, preprocessor)
+	}
+}
+
+// WithPostprocessor adds a function that transforms the html generated by mjml. Postprocessors run in the order they
+// are added, after the footer, tracking pixel and skeleton are applied. When mjml returns validation errors, their
+// Error is returned even if a postprocessor fails on the html compiled despite them.
+func WithPostprocessor(postprocessor func(ctx context.Context, html string) (string, error)) ToHTMLOption {
+	return func(o options) {
+		o.local.postprocessors = append(o.local.postprocessors, postprocessor)
+	}
+}
+
+// preprocess runs the preprocessors on the mjml
+func (l *localOptions) preprocess(ctx context.Context, mjml string) (string, error) {
+	for i, preprocessor := range l.preprocessors {
+		var err error
+
+		if mjml, err = preprocessor(ctx, mjml); err != nil {
+			return "", fmt.Errorf("error running preprocessor %d: %w", i+1, err)
+		}
+	}
+
+	return mjml, nil
+}
+
+// postprocess injects the footer, tracking pixel and color-scheme meta tags, applies the skeleton and runs the
+// postprocessors on the html
+func (l *localOptions) postprocess(ctx context.Context, html string) (string, error) {
+	if html == "" {
+		return html, nil
+	}
+
+	html, err := l.inject(html)
+
+	if err != nil {
+		return "", fmt.Errorf("error injecting footer and tracking pixel: %w", err)
+	}
+
+	html = l.injectColorScheme(html)
+
+	if html, err = l.applySkeleton(html); err != nil {
+		return "", fmt.Errorf("error applying skeleton: %w", err)
+	}
+
+	for i, postprocessor := range l.postprocessors {
+		if html, err = postprocessor(ctx, html); err != nil {
+			return "", fmt.Errorf("error running postprocessor %d: %w", i+1, err)
+		}
+	}
+
+	return html, nil
+}
@@ -0,0 +1,86 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestProcessors(t *testing.T) {
+
+	input := `<mjml><mj-body><mj-section><mj-column><mj-text>{{greeting}}</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+	var calls []string
+
+	output, err := ToHTML(context.Background(), input,
+		WithPreprocessor(func(_ context.Context, mjml string) (string, error) {
+			calls = append(calls, "first preprocessor")
+			return strings.ReplaceAll(mjml, "{{greeting}}", "{{name}}"), nil
+		}),
+		WithPreprocessor(func(_ context.Context, mjml string) (string, error) {
+			calls = append(calls, "second preprocessor")
+			return strings.ReplaceAll(mjml, "{{name}}", "Hello World"), nil
+		}),
+		WithPostprocessor(func(_ context.Context, html string) (string, error) {
+			calls = append(calls, "postprocessor")
+			return strings.ReplaceAll(html, "Hello World", "Hello Postprocessor"), nil
+		}),
+	)
+
+	if err != nil {
+		t.Fatalf("Error converting mjml to html with processors: %s", err)
+	}
+
+	if !strings.Contains(output, "Hello Postprocessor") {
+		t.Errorf("Expected the output to be processed, got: %s", output)
+	}
+
+	if strings.Join(calls, ", ") != "first preprocessor, second preprocessor, postprocessor" {
+		t.Errorf("Expected processors to run in order, got: %v", calls)
+	}
+
+	errFailed := errors.New("failed")
+
+	failing := func(_ context.Context, s string) (string, error) {
+		return "", errFailed
+	}
+
+	_, err = ToHTML(context.Background(), input, WithPreprocessor(failing))
+
+	if !errors.Is(err, errFailed) || !strings.Contains(err.Error(), "preprocessor 1") {
+		t.Errorf("Expected a preprocessor error, got: %v", err)
+	}
+
+	_, err = ToHTML(context.Background(), input, WithPostprocessor(noopProcessor), WithPostprocessor(failing))
+
+	if !errors.Is(err, errFailed) || !strings.Contains(err.Error(), "postprocessor 2") {
+		t.Errorf("Expected a postprocessor error, got: %v", err)
+	}
+
+	invalid := strings.Replace(input, "<mj-text>", `<mj-text color="not-a-color">`, 1)
+
+	var mjmlError Error
+
+	_, err = ToHTML(context.Background(), invalid, WithValidationLevel(Soft), WithPostprocessor(failing))
+
+	if !errors.As(err, &mjmlError) || len(mjmlError.Details) != 1 {
+		t.Errorf("Expected the validation error to be returned before postprocessor errors, got: %v", err)
+	}
+
+	var optionError OptionError
+
+	if _, err = ToHTML(context.Background(), input, WithPreprocessors([]string{"(xml) => xml"})); !errors.As(err, &optionError) {
+		t.Errorf("Expected an option error for string preprocessors, got: %v", err)
+	}
+
+	for _, preprocessors := range [][]string{nil, {}} {
+		if _, err = ToHTML(context.Background(), input, WithPreprocessors(preprocessors)); err != nil {
+			t.Errorf("Expected empty string preprocessors %#v to be ignored, got: %v", preprocessors, err)
+		}
+	}
+}
+
+func noopProcessor(_ context.Context, s string) (string, error) {
+	return s, nil
+}
@@ -0,0 +1,224 @@
+// Package server provides an http.Handler compiling MJML using the same JSON protocol as the Node.js server in
+// js/src/server.js, so it can replace a Node.js deployment without changing its clients.
+//
+// Requests are POSTed as {"mjml": "...", "options": {...}}, where options are passed to mjml2html as they are, and
+// responses are returned as {"html": "...", "error": {"message": "...", "details": [...]}}. Options that the browser
+// build of mjml used by the Node.js server ignores, such as filePath, are ignored as well.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+const (
+	// HealthPath responds with 200 OK while the server is running
+	HealthPath = "/healthz"
+
+	// ReadyPath responds with 200 OK when templates can be compiled, and 503 Service Unavailable otherwise
+	ReadyPath = "/readyz"
+
+	// DefaultMaxRequestSize is the default maximum size of request bodies in bytes
+	DefaultMaxRequestSize = 5 << 20
+
+	// DefaultTimeout is the default maximum duration requests wait for their compilation
+	DefaultTimeout = 30 * time.Second
+)
+
+// readinessTemplate is compiled to check that the server is ready
+const readinessTemplate = `<mjml><mj-body></mj-body></mjml>`
+
+// ignoredOptions are the options of mjml2html that the Node.js server accepts without effect, because they need a
+// file system, and that are rejected by mjml.WithRawOptions
+var ignoredOptions = []string{
+	"actualPath",
+	"filePath",
+	"mjmlConfigPath",
+	"preprocessors",
+	"useMjmlConfigOptions",
+}
+
+type request struct {
+	MJML    string                 `json:"mjml"`
+	Options map[string]interface{} `json:"options"`
+}
+
+type response struct {
+	HTML  string     `json:"html,omitempty"`
+	Error *errorBody `json:"error,omitempty"`
+}
+
+type result struct {
+	html string
+	err  error
+}
+
+type errorBody struct {
+	Message string             `json:"message"`
+	Details []mjml.ErrorDetail `json:"details,omitempty"`
+}
+
+// Handler is an http.Handler compiling MJML sent using the protocol of js/src/server.js. Like the Node.js server,
+// requests to any path other than HealthPath and ReadyPath are compiled, and errors are returned with a 200 OK
+// status, except for requests that exceed the size limit or the timeout.
+type Handler struct {
+	maxRequestSize int64
+	timeout        time.Duration
+	options        []mjml.ToHTMLOption
+}
+
+// Option configures a Handler
+type Option func(*Handler)
+
+// WithMaxRequestSize limits the size of request bodies in bytes
+func WithMaxRequestSize(size int64) Option {
+	return func(h *Handler) {
+		h.maxRequestSize = size
+	}
+}
+
+// WithTimeout limits how long requests wait for their compilation
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.timeout = timeout
+	}
+}
+
+// WithToHTMLOptions sets options applied to every compilation before the options in the request
+func WithToHTMLOptions(options ...mjml.ToHTMLOption) Option {
+	return func(h *Handler) {
+		h.options = options
+	}
+}
+
+// New returns a Handler using DefaultMaxRequestSize and DefaultTimeout unless they are changed by options
+func New(options ...Option) *Handler {
+	h := &Handler{
+		maxRequestSize: DefaultMaxRequestSize,
+		timeout:        DefaultTimeout,
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case HealthPath:
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+
+	case ReadyPath:
+		h.serveReady(w, r)
+
+	default:
+		h.serveCompile(w, r)
+	}
+}
+
+func (h *Handler) serveReady(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	if _, err := mjml.ToHTML(ctx, readinessTemplate); err != nil {
+		http.Error(w, fmt.Sprintf("not ready: %s", err), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
+
+func (h *Handler) serveCompile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeResponse(w, http.StatusOK, errorResponse("Only POST requests are accepted"))
+		return
+	}
+
+	var req request
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxRequestSize)).Decode(&req); err != nil {
+		var maxBytesError *http.MaxBytesError
+
+		if errors.As(err, &maxBytesError) {
+			writeResponse(w, http.StatusRequestEntityTooLarge, errorResponse(fmt.Sprintf("request body is larger than %d bytes", maxBytesError.Limit)))
+			return
+		}
+
+		writeResponse(w, http.StatusOK, errorResponse(err.Error()))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	for _, name := range ignoredOptions {
+		delete(req.Options, name)
+	}
+
+	options := slices.Concat(h.options, []mjml.ToHTMLOption{mjml.WithRawOptions(req.Options)})
+
+	// A compilation that is running in a worker cannot be interrupted, so it finishes in the background if it times out
+	results := make(chan result, 1)
+
+	go func() {
+		html, err := mjml.ToHTML(ctx, req.MJML, options...)
+		results <- result{html: html, err: err}
+	}()
+
+	var res result
+
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		res.err = ctx.Err()
+	}
+
+	html, err := res.html, res.err
+
+	var mjmlError mjml.Error
+
+	switch {
+	case err == nil:
+		writeResponse(w, http.StatusOK, response{HTML: html})
+
+	case errors.As(err, &mjmlError):
+		writeResponse(w, http.StatusOK, response{
+			HTML: mjmlError.HTML,
+			Error: &errorBody{
+				Message: mjmlError.Message,
+				Details: mjmlError.Details,
+			},
+		})
+
+	case ctx.Err() != nil && r.Context().Err() == nil:
+		writeResponse(w, http.StatusGatewayTimeout, errorResponse(fmt.Sprintf("compilation timed out after %s", h.timeout)))
+
+	default:
+		writeResponse(w, http.StatusOK, errorResponse(err.Error()))
+	}
+}
+
+func errorResponse(message string) response {
+	return response{Error: &errorBody{Message: message}}
+}
+
+func writeResponse(w http.ResponseWriter, status int, res response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	_ = encoder.Encode(res)
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Boostport/mjml-go"
+)
+
+const testTemplate = `<mjml><mj-body><mj-section><mj-column><mj-text>Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+type testResponse struct {
+	HTML  string `json:"html"`
+	Error *struct {
+		Message string             `json:"message"`
+		Details []mjml.ErrorDetail `json:"details"`
+	} `json:"error"`
+}
+
+func compile(t *testing.T, handler http.Handler, method string, body string) (int, testResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if contentType := rec.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("Expected a JSON response, got %s", contentType)
+	}
+
+	var res testResponse
+
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("Error decoding response %q: %s", rec.Body.String(), err)
+	}
+
+	return rec.Code, res
+}
+
+func TestCompile(t *testing.T) {
+
+	handler := New()
+
+	status, res := compile(t, handler, http.MethodPost, `{"mjml": "`+strings.ReplaceAll(testTemplate, `"`, `\"`)+`", "options": {"minify": true, "minifyOptions": {"collapseWhitespace": true}}}`)
+
+	if status != http.StatusOK || res.Error != nil {
+		t.Fatalf("Expected a successful compilation, got %d: %+v", status, res.Error)
+	}
+
+	if !strings.Contains(res.HTML, "Hello World") || !strings.Contains(res.HTML, "</div></body></html>") {
+		t.Errorf("Expected minified html containing the text, got: %s", res.HTML)
+	}
+}
+
+func TestCompileNodeOptions(t *testing.T) {
+
+	handler := New()
+
+	body := `{"mjml": "` + strings.ReplaceAll(testTemplate, `"`, `\"`) + `", "options": {"filePath": "templates/welcome.mjml", "actualPath": "templates/welcome.mjml", "mjmlConfigPath": ".mjmlconfig", "preprocessors": [], "keepComments": false}}`
+
+	status, res := compile(t, handler, http.MethodPost, body)
+
+	if status != http.StatusOK || res.Error != nil {
+		t.Fatalf("Expected options ignored by the Node.js server to be ignored, got %d: %+v", status, res.Error)
+	}
+
+	if !strings.Contains(res.HTML, "Hello World") {
+		t.Errorf("Expected html containing the text, got: %s", res.HTML)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+
+	handler := New()
+
+	invalid := strings.Replace(testTemplate, "<mj-text>", `<mj-text color=\"not-a-color\">`, 1)
+
+	status, res := compile(t, handler, http.MethodPost, `{"mjml": "`+invalid+`", "options": {"validationLevel": "soft"}}`)
+
+	if status != http.StatusOK || res.Error == nil || res.Error.Message != "MJML compilation error" || len(res.Error.Details) != 1 {
+		t.Errorf("Expected a validation error, got %d: %+v", status, res.Error)
+	}
+
+	if !strings.Contains(res.HTML, "Hello World") {
+		t.Errorf("Expected the html compiled despite the validation error, got: %s", res.HTML)
+	}
+
+	tests := map[string]struct {
+		method  string
+		body    string
+		message string
+	}{
+		"get":          {method: http.MethodGet, message: "Only POST requests are accepted"},
+		"invalid json": {method: http.MethodPost, body: `{"mjml": `, message: "unexpected EOF"},
+		"missing mjml": {method: http.MethodPost, body: `{}`, message: "input is missing mjml property"},
+	}
+
+	for name, test := range tests {
+		status, res := compile(t, handler, test.method, test.body)
+
+		if status != http.StatusOK || res.Error == nil || res.Error.Message != test.message {
+			t.Errorf("Expected error %q for %s, got %d: %+v", test.message, name, status, res.Error)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+
+	body := `{"mjml": "` + strings.ReplaceAll(testTemplate, `"`, `\"`) + `"}`
+
+	status, res := compile(t, New(WithMaxRequestSize(16)), http.MethodPost, body)
+
+	if status != http.StatusRequestEntityTooLarge || res.Error == nil || res.Error.Message != "request body is larger than 16 bytes" {
+		t.Errorf("Expected the request to be too large, got %d: %+v", status, res.Error)
+	}
+
+	status, res = compile(t, New(WithTimeout(time.Nanosecond)), http.MethodPost, body)
+
+	if status != http.StatusGatewayTimeout || res.Error == nil || !strings.HasPrefix(res.Error.Message, "compilation timed out") {
+		t.Errorf("Expected the compilation to time out, got %d: %+v", status, res.Error)
+	}
+}
+
+func TestHealthAndReadiness(t *testing.T) {
+
+	handler := New()
+
+	for _, path := range []string{HealthPath, ReadyPath} {
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status %d for %s, got %d: %s", http.StatusOK, path, rec.Code, rec.Body.String())
+		}
+	}
+}
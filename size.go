@@ -0,0 +1,219 @@
+package mjml
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// GmailClipSize is the size of html above which Gmail clips messages, hiding the rest behind a link
+const GmailClipSize = 102 * 1024
+
+// SizeContributor is a kind of content contributing to the size of the compiled html
+type SizeContributor string
+
+const (
+	// SizeInlineStyles are the style attributes of elements
+	SizeInlineStyles SizeContributor = "inline styles"
+
+	// SizeMediaQueries are the @media rules of style elements
+	SizeMediaQueries SizeContributor = "media queries"
+
+	// SizeComments are html comments other than conditional comments, which are removed by minifying using
+	// HTMLMinifierOptions.RemoveComments
+	SizeComments SizeContributor = "comments"
+
+	// SizeConditionalComments are the conditional comments containing the markup for Outlook
+	SizeConditionalComments SizeContributor = "conditional comments"
+
+	// SizeWhitespace is the whitespace removed by collapsing whitespace, as done by WithMinify
+	SizeWhitespace SizeContributor = "whitespace"
+)
+
+// SizeContribution is the number of bytes of a kind of content
+type SizeContribution struct {
+	Contributor SizeContributor
+	Bytes       int
+}
+
+// SizeReport describes the size of the compiled html compared to the budget set using WithSizeBudget
+type SizeReport struct {
+	// Size is the size of the html in bytes
+	Size int
+
+	// Limit is the size budget in bytes
+	Limit int
+
+	// Contributions are the bytes of each kind of content, largest first. The kinds overlap, for example whitespace
+	// in conditional comments is counted twice.
+	Contributions []SizeContribution
+}
+
+// Exceeded reports whether the html is larger than the budget
+func (r *SizeReport) Exceeded() bool {
+	return r.Size > r.Limit
+}
+
+// SizeBudgetError is returned when the compiled html exceeds the size budget and the level is Strict
+type SizeBudgetError struct {
+	SizeReport
+
+	// HTML is the compiled html
+	HTML string
+}
+
+func (e SizeBudgetError) Error() string {
+	contributions := make([]string, 0, len(e.Contributions))
+
+	for _, contribution := range e.Contributions {
+		contributions = append(contributions, fmt.Sprintf("%s %s", contribution.Contributor, formatSize(contribution.Bytes)))
+	}
+
+	return fmt.Sprintf("html is %s, exceeding the size budget of %s (%s)", formatSize(e.Size), formatSize(e.Limit),
+		strings.Join(contributions, ", "))
+}
+
+// WithSizeBudget checks the size of the compiled html against limit, such as GmailClipSize, and reports the size of
+// the largest contributors in Result.SizeReport. If the level is Strict, a SizeBudgetError is returned when the html
+// exceeds the limit. If the level is Soft, the html is returned and the report can be checked using
+// SizeReport.Exceeded. If the level is Skip, the size is not checked.
+func WithSizeBudget(limit int, level ValidationLevel) ToHTMLOption {
+	return func(o options) {
+		o.local.sizeBudget = sizeBudget{limit: limit, level: level}
+	}
+}
+
+type sizeBudget struct {
+	limit int
+	level ValidationLevel
+}
+
+func (b sizeBudget) enabled() bool {
+	return b.level != "" && b.level != Skip
+}
+
+// checkSizeBudget checks the limit and level of the size budget
+func (l *localOptions) checkSizeBudget() error {
+	if l.sizeBudget.level == "" {
+		return nil
+	}
+
+	if l.sizeBudget.level != Strict && l.sizeBudget.level != Soft && l.sizeBudget.level != Skip {
+		return OptionError{Option: "sizeBudget", Err: fmt.Errorf("invalid level %q", l.sizeBudget.level)}
+	}
+
+	if l.sizeBudget.limit <= 0 {
+		return OptionError{Option: "sizeBudget", Err: errors.New("limit must be positive")}
+	}
+
+	return nil
+}
+
+// checkSize returns the size report of the html, and a SizeBudgetError if it exceeds a strict budget
+func (l *localOptions) checkSize(html string) (*SizeReport, error) {
+	if !l.sizeBudget.enabled() {
+		return nil, nil
+	}
+
+	report := &SizeReport{Size: len(html), Limit: l.sizeBudget.limit, Contributions: sizeContributions(html)}
+
+	if report.Exceeded() && l.sizeBudget.level == Strict {
+		return report, SizeBudgetError{SizeReport: *report, HTML: html}
+	}
+
+	return report, nil
+}
+
+var (
+	inlineStyle       = regexp.MustCompile(`(?i)\sstyle\s*=\s*("[^"]*"|'[^']*'|[^\s>]+)`)
+	styleElement      = regexp.MustCompile(`(?is)<style[^>]*>(.*?)</style>`)
+	comment           = regexp.MustCompile(`(?s)<!--.*?-->`)
+	conditionalStart  = regexp.MustCompile(`^<!--\s*\[if`)
+	whitespaceRun     = regexp.MustCompile(`\s+`)
+	whitespaceBetween = regexp.MustCompile(`>\s+<`)
+)
+
+// sizeContributions measures the kinds of content of html
+func sizeContributions(html string) []SizeContribution {
+	bytes := map[SizeContributor]int{}
+
+	for _, match := range inlineStyle.FindAllString(html, -1) {
+		bytes[SizeInlineStyles] += len(match)
+	}
+
+	for _, match := range styleElement.FindAllStringSubmatch(html, -1) {
+		bytes[SizeMediaQueries] += mediaQueriesSize(match[1])
+	}
+
+	for _, match := range comment.FindAllString(html, -1) {
+		// the end of the downlevel-revealed conditional comments hiding content from Outlook is a plain comment
+		if conditionalStart.MatchString(match) || strings.HasPrefix(match, "<!--<![endif]") {
+			bytes[SizeConditionalComments] += len(match)
+		} else {
+			bytes[SizeComments] += len(match)
+		}
+	}
+
+	// whitespace between tags is removed, and other runs of whitespace are collapsed to a single space
+	for _, match := range whitespaceBetween.FindAllString(html, -1) {
+		bytes[SizeWhitespace] += len(match) - 2
+	}
+
+	for _, match := range whitespaceRun.FindAllString(whitespaceBetween.ReplaceAllString(html, "><"), -1) {
+		bytes[SizeWhitespace] += len(match) - 1
+	}
+
+	contributions := make([]SizeContribution, 0, len(bytes))
+
+	for _, contributor := range []SizeContributor{SizeInlineStyles, SizeMediaQueries, SizeComments, SizeConditionalComments, SizeWhitespace} {
+		contributions = append(contributions, SizeContribution{Contributor: contributor, Bytes: bytes[contributor]})
+	}
+
+	sort.SliceStable(contributions, func(i, j int) bool {
+		return contributions[i].Bytes > contributions[j].Bytes
+	})
+
+	return contributions
+}
+
+// mediaQueriesSize returns the size of the @media rules in css, including their nested rules
+func mediaQueriesSize(css string) int {
+	var size int
+
+	for {
+		start := strings.Index(css, "@media")
+
+		if start < 0 {
+			return size
+		}
+
+		end := len(css)
+		depth := 0
+
+		for i := start; i < len(css); i++ {
+			if css[i] == '{' {
+				depth++
+			} else if css[i] == '}' {
+				depth--
+
+				if depth == 0 {
+					end = i + 1
+					break
+				}
+			}
+		}
+
+		size += end - start
+		css = css[end:]
+	}
+}
+
+func formatSize(bytes int) string {
+	if bytes < 1024 {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	return fmt.Sprintf("%.1f KB", float64(bytes)/1024)
+}
@@ -0,0 +1,90 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSizeContributions(t *testing.T) {
+
+	html := "<html><head><style>a{color:red}@media (max-width:480px){.a{width:100%}}</style></head><body>  " +
+		`<!--[if mso]><p>o</p><![endif]--><!-- note --><div style="color:red">a  b</div>` + "\n</body></html>"
+
+	expected := []SizeContribution{
+		{Contributor: SizeMediaQueries, Bytes: 40},
+		{Contributor: SizeConditionalComments, Bytes: 33},
+		{Contributor: SizeInlineStyles, Bytes: 18},
+		{Contributor: SizeComments, Bytes: 13},
+		{Contributor: SizeWhitespace, Bytes: 4},
+	}
+
+	if contributions := sizeContributions(html); !reflect.DeepEqual(contributions, expected) {
+		t.Errorf("Expected %v, got %v", expected, contributions)
+	}
+}
+
+func TestSizeBudget(t *testing.T) {
+
+	input := "<mjml><mj-body><mj-section><mj-column><mj-text>Hello</mj-text></mj-column></mj-section></mj-body></mjml>"
+
+	result, err := Compile(context.Background(), input, WithSizeBudget(GmailClipSize, Strict))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if result.Size != len(result.HTML) || result.SizeReport == nil || result.SizeReport.Exceeded() {
+		t.Errorf("Expected a size report within the budget, got: %v", result.SizeReport)
+	}
+
+	result, err = Compile(context.Background(), input, WithSizeBudget(1024, Soft))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if !result.SizeReport.Exceeded() || result.SizeReport.Limit != 1024 || len(result.SizeReport.Contributions) != 5 {
+		t.Errorf("Expected an exceeded size report, got: %v", result.SizeReport)
+	}
+
+	_, err = Compile(context.Background(), input, WithSizeBudget(1024, Strict))
+
+	var sizeErr SizeBudgetError
+
+	if !errors.As(err, &sizeErr) || sizeErr.HTML == "" || sizeErr.Size != len(sizeErr.HTML) {
+		t.Fatalf("Expected a size budget error, got: %v", err)
+	}
+
+	if !strings.HasPrefix(sizeErr.Error(), "html is ") || !strings.Contains(sizeErr.Error(), "exceeding the size budget of 1.0 KB (") {
+		t.Errorf("Unexpected error message: %s", sizeErr.Error())
+	}
+
+	minified, err := Compile(context.Background(), input, WithSizeBudget(1024, Soft), WithMinify(true))
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	if minified.Size >= result.Size || whitespace(minified.SizeReport) >= whitespace(result.SizeReport) {
+		t.Error("Expected minifying to reduce the size and whitespace")
+	}
+
+	result, err = Compile(context.Background(), input, WithSizeBudget(1024, Skip))
+
+	if err != nil || result.SizeReport != nil {
+		t.Errorf("Expected no size report when skipping the check, got: %v (%v)", result.SizeReport, err)
+	}
+}
+
+func whitespace(report *SizeReport) int {
+	for _, contribution := range report.Contributions {
+		if contribution.Contributor == SizeWhitespace {
+			return contribution.Bytes
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,140 @@
+package mjml
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// SkeletonData contains the parts of the document generated by mjml, which are passed to the template set using
+// WithSkeleton
+type SkeletonData struct {
+	// Lang and Dir are the lang and dir attributes of the html element
+	Lang string
+	Dir  string
+
+	// Title is the title of the document
+	Title string
+
+	// Head contains the elements of the head, including the title, meta tags, fonts and styles
+	Head string
+
+	// BodyStyle is the style attribute of the body, which sets the background color
+	BodyStyle string
+
+	// Content contains the elements of the body, including the preview
+	Content string
+}
+
+// WithSkeleton replaces the skeleton of the document generated by mjml, which is the html, head and body elements
+// around the content. The skeleton is a text/template executed with SkeletonData:
+//
+//	<!doctype html>
+//	<html lang="{{.Lang}}">
+//	<head>{{.Head}}<meta name="color-scheme" content="light"></head>
+//	<body style="{{.BodyStyle}}">{{.Content}}</body>
+//	</html>
+//
+// The skeleton is applied after the document is minified or beautified, so it is output as it is.
+func WithSkeleton(skeleton string) ToHTMLOption {
+	return func(o options) {
+		o.local.skeleton = skeleton
+	}
+}
+
+var (
+	skeletonLang      = regexp.MustCompile(`^<html[^>]*\slang="([^"]*)"`)
+	skeletonDir       = regexp.MustCompile(`^<html[^>]*\sdir="([^"]*)"`)
+	skeletonTitle     = regexp.MustCompile(`(?s)<title>(.*?)</title>`)
+	skeletonBodyStyle = regexp.MustCompile(`\sstyle="([^"]*)"`)
+)
+
+func parseSkeleton(skeleton string) (*template.Template, error) {
+	return template.New("skeleton").Parse(skeleton)
+}
+
+// applySkeleton renders the document generated by mjml using the skeleton
+func (l *localOptions) applySkeleton(html string) (string, error) {
+	if l.skeleton == "" || html == "" {
+		return html, nil
+	}
+
+	tmpl, err := parseSkeleton(l.skeleton)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := skeletonData(html)
+
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+// skeletonData splits the document generated by mjml into the parts passed to skeletons
+func skeletonData(html string) (SkeletonData, error) {
+	var data SkeletonData
+
+	htmlStart := strings.Index(html, "<html")
+	headStart := strings.Index(html, "<head>")
+	headEnd := strings.Index(html, "</head>")
+	bodyStart := strings.Index(html, "<body")
+	bodyEnd := strings.LastIndex(html, "</body>")
+
+	if htmlStart < 0 || headStart < 0 || headEnd < headStart || bodyStart < headEnd || bodyEnd < bodyStart {
+		return data, errors.New("document generated by mjml does not have a head and body")
+	}
+
+	bodyTagEnd := strings.IndexByte(html[bodyStart:], '>')
+
+	if bodyTagEnd < 0 {
+		return data, errors.New("document generated by mjml does not have a head and body")
+	}
+
+	bodyTagEnd += bodyStart
+
+	data.Head = html[headStart+len("<head>") : headEnd]
+	data.Content = html[bodyTagEnd+1 : bodyEnd]
+
+	if match := skeletonLang.FindStringSubmatch(html[htmlStart:]); match != nil {
+		data.Lang = match[1]
+	}
+
+	if match := skeletonDir.FindStringSubmatch(html[htmlStart:]); match != nil {
+		data.Dir = match[1]
+	}
+
+	if match := skeletonTitle.FindStringSubmatch(data.Head); match != nil {
+		data.Title = match[1]
+	}
+
+	if match := skeletonBodyStyle.FindStringSubmatch(html[bodyStart:bodyTagEnd]); match != nil {
+		data.BodyStyle = match[1]
+	}
+
+	return data, nil
+}
+
+// checkSkeleton checks that the skeleton is a valid template
+func (l *localOptions) checkSkeleton() error {
+	if l.skeleton == "" {
+		return nil
+	}
+
+	if _, err := parseSkeleton(l.skeleton); err != nil {
+		return OptionError{Option: "skeleton", Err: fmt.Errorf("invalid template: %w", err)}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package mjml
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+
+	input := `<mjml lang="en"><mj-body background-color="#eeeeee"><mj-section><mj-column><mj-text>Hello World</mj-text></mj-column></mj-section></mj-body></mjml>`
+
+	skeleton := `<!doctype html><html lang="{{.Lang}}"><head>{{.Head}}<meta name="x-skeleton" content="{{.Title}}"></head><body style="{{.BodyStyle}}">{{.Content}}</body></html>`
+
+	for _, minify := range []bool{false, true} {
+		output, err := ToHTML(context.Background(), input, WithSkeleton(skeleton), WithTitle("Welcome"), WithMinify(minify))
+
+		if err != nil {
+			t.Fatalf("Error converting mjml to html with a skeleton: %s", err)
+		}
+
+		for _, expected := range []string{
+			`<!doctype html><html lang="en"><head>`,
+			`<title>Welcome</title>`,
+			`<meta name="x-skeleton" content="Welcome"></head><body style="word-spacing:normal;background-color:#eeeeee;">`,
+			`Hello World`,
+		} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected output to contain %q, got: %s", expected, output)
+			}
+		}
+
+		if !strings.HasSuffix(strings.TrimSpace(output), "</body></html>") {
+			t.Errorf("Expected output to end with the skeleton, got: %s", output)
+		}
+	}
+
+	if _, err := skeletonData("<div>Hello World</div>"); err == nil {
+		t.Error("Expected an error for a document without a head and body")
+	}
+}
@@ -0,0 +1,463 @@
+package mjml
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Boostport/mjml-go/ast"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// DefaultTextWidth is the width plain text returned by Result.Text is wrapped at, unless it is changed using
+// WithTextWidth
+const DefaultTextWidth = 78
+
+// Result is a template compiled using Compile
+type Result struct {
+	// HTML is the compiled html
+	HTML string
+
+	// Images are the images embedded using WithInlineImages, which are referenced by the html using cid: URLs
+	Images []InlineImage
+
+	// Size is the size of the html in bytes
+	Size int
+
+	// SizeReport describes the size of the html compared to the budget set using WithSizeBudget, which is nil if no
+	// budget is set
+	SizeReport *SizeReport
+
+	mjml      string
+	lineMap   ast.LineMap
+	textWidth int
+}
+
+// MJML returns the mjml compiled into the html, after includes, components, themes and other options are applied. The
+// lines of the nodes refer to the original source, and are 0 for nodes that were added by options.
+func (r *Result) MJML() (*ast.Node, error) {
+	root, err := ast.Parse(r.mjml)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing mjml: %w", err)
+	}
+
+	if r.lineMap != nil {
+		root.Walk(func(n *ast.Node) bool {
+			n.Line = r.lineMap[n.Line]
+			return true
+		})
+	}
+
+	return root, nil
+}
+
+// WithTextWidth sets the width plain text returned by Result.Text is wrapped at. Lines are not wrapped if width is 0.
+func WithTextWidth(width int) ToHTMLOption {
+	return func(o options) {
+		o.local.textWidth = width
+	}
+}
+
+// Text returns a plain text version of the template, for the text/plain part of emails. It is derived from the mjml
+// rather than the html, so the layout tables of the html do not end up in the text. Sections and the content of
+// components are separated by blank lines, buttons and links are followed by their URLs, images are replaced by their
+// alt text and tables are aligned in columns. The elements of mj-head, including mj-preview, are excluded.
+func (r *Result) Text() (string, error) {
+	root, err := ast.Parse(r.mjml)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing mjml: %w", err)
+	}
+
+	c := textConverter{declared: ast.NewAttributes(root)}
+
+	if body := root.Child("mj-body"); body != nil {
+		c.component(body)
+	}
+
+	paragraphs := make([]string, 0, len(c.blocks))
+
+	for _, block := range c.blocks {
+		if block.pre {
+			paragraphs = append(paragraphs, block.text)
+			continue
+		}
+
+		if text := wrapText(block.text, r.textWidth); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(paragraphs, "\n\n") + "\n", nil
+}
+
+type textBlock struct {
+	text string
+
+	// pre is set for blocks that are output as they are, such as aligned tables
+	pre bool
+}
+
+// textConverter converts mjml components to blocks of text
+type textConverter struct {
+	declared *ast.Attributes
+	blocks   []textBlock
+	current  strings.Builder
+}
+
+func (c *textConverter) component(n *ast.Node) {
+	if n.Type != ast.ElementNode {
+		return
+	}
+
+	switch n.Tag {
+	case "mj-text", "mj-raw", "mj-accordion-title", "mj-accordion-text":
+		c.html(n.Content)
+
+	case "mj-table":
+		c.html("<table>" + n.Content + "</table>")
+
+	case "mj-button":
+		c.add(textBlock{text: linkText(inlineText(n.Content), declaredAttr(c.declared, n, "href"))})
+
+	case "mj-image", "mj-carousel-image":
+		if alt := declaredAttr(c.declared, n, "alt"); alt != "" {
+			c.add(textBlock{text: alt})
+		}
+
+	case "mj-navbar", "mj-social":
+		var (
+			lines   []string
+			baseURL string
+		)
+
+		if n.Tag == "mj-navbar" {
+			baseURL = declaredAttr(c.declared, n, "base-url")
+		}
+
+		for _, child := range n.Children {
+			if child.Type != ast.ElementNode {
+				continue
+			}
+
+			label := inlineText(child.Content)
+
+			if label == "" {
+				label = declaredAttr(c.declared, child, "name")
+			}
+
+			// mjml prepends the base-url of the navbar to its links
+			if line := linkText(label, baseURL+declaredAttr(c.declared, child, "href")); line != "" {
+				lines = append(lines, line)
+			}
+		}
+
+		c.add(textBlock{text: strings.Join(lines, "\n")})
+
+	default:
+		for _, child := range n.Children {
+			c.component(child)
+		}
+	}
+}
+
+func (c *textConverter) add(block textBlock) {
+	if strings.TrimSpace(block.text) != "" {
+		c.blocks = append(c.blocks, block)
+	}
+}
+
+// html converts the html content of a component
+func (c *textConverter) html(content string) {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+
+	if err != nil {
+		c.add(textBlock{text: content})
+		return
+	}
+
+	for _, n := range nodes {
+		c.htmlNode(n)
+	}
+
+	c.flush()
+}
+
+func (c *textConverter) htmlNode(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.current.WriteString(strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return ' '
+			}
+
+			return r
+		}, n.Data))
+
+		return
+
+	case html.ElementNode:
+
+	default:
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Head, atom.Title:
+
+	case atom.Br:
+		c.current.WriteString("\n")
+
+	case atom.Img:
+		c.current.WriteString(htmlAttr(n, "alt"))
+
+	case atom.A:
+		start := c.current.Len()
+		c.htmlChildren(n)
+		label := strings.Join(strings.Fields(c.current.String()[start:]), " ")
+		href := htmlAttr(n, "href")
+
+		if href != "" && !strings.HasPrefix(href, "#") && label != strings.TrimPrefix(href, "mailto:") && label != href {
+			c.current.WriteString(" (" + href + ")")
+		}
+
+	case atom.Li:
+		if text := c.current.String(); text != "" && !strings.HasSuffix(text, "\n") {
+			c.current.WriteString("\n")
+		}
+
+		c.current.WriteString("- ")
+		c.htmlChildren(n)
+		c.current.WriteString("\n")
+
+	case atom.Table:
+		c.flush()
+		c.add(textBlock{text: alignTable(tableRows(n)), pre: true})
+
+	case atom.Pre:
+		c.flush()
+		c.add(textBlock{text: strings.Trim(nodeText(n), "\n"), pre: true})
+
+	case atom.P, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Ul, atom.Ol, atom.Blockquote,
+		atom.Section, atom.Header, atom.Footer, atom.Article, atom.Center, atom.Hr:
+		c.flush()
+		c.htmlChildren(n)
+		c.flush()
+
+	default:
+		c.htmlChildren(n)
+	}
+}
+
+func (c *textConverter) htmlChildren(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.htmlNode(child)
+	}
+}
+
+// flush adds the text converted since the last block as a block
+func (c *textConverter) flush() {
+	c.add(textBlock{text: c.current.String()})
+	c.current.Reset()
+}
+
+// tableRows returns the text of the cells of the rows of a table, excluding the rows of nested tables
+func tableRows(table *html.Node) [][]string {
+	var (
+		rows [][]string
+		walk func(n *html.Node)
+	)
+
+	walk = func(n *html.Node) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			switch child.DataAtom {
+			case atom.Tr:
+				var cells []string
+
+				for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+						cells = append(cells, cellText(cell))
+					}
+				}
+
+				rows = append(rows, cells)
+
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				walk(child)
+			}
+		}
+	}
+
+	walk(table)
+
+	return rows
+}
+
+// cellText converts the content of a table cell to a single line
+func cellText(cell *html.Node) string {
+	var c textConverter
+
+	c.htmlChildren(cell)
+	c.flush()
+
+	texts := make([]string, 0, len(c.blocks))
+
+	for _, block := range c.blocks {
+		texts = append(texts, block.text)
+	}
+
+	return strings.Join(strings.Fields(strings.Join(texts, " ")), " ")
+}
+
+// alignTable pads the cells of rows so that columns are aligned
+func alignTable(rows [][]string) string {
+	var widths []int
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	lines := make([]string, 0, len(rows))
+
+	for _, row := range rows {
+		var sb strings.Builder
+
+		for i, cell := range row {
+			if i > 0 {
+				sb.WriteString("  ")
+			}
+
+			sb.WriteString(cell)
+			sb.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+
+		if line := strings.TrimRight(sb.String(), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wrapText collapses the whitespace of each line of text and wraps lines longer than width. Continuation lines of
+// list items are indented.
+func wrapText(text string, width int) string {
+	var lines []string
+
+	for _, line := range strings.Split(text, "\n") {
+		words := strings.Fields(line)
+
+		if len(words) == 0 {
+			if len(lines) > 0 && lines[len(lines)-1] != "" {
+				lines = append(lines, "")
+			}
+
+			continue
+		}
+
+		indent := ""
+
+		if words[0] == "-" {
+			indent = "  "
+		}
+
+		var sb strings.Builder
+
+		for _, word := range words {
+			length := utf8.RuneCountInString(sb.String())
+
+			switch {
+			case length == 0:
+			case width > 0 && length+1+utf8.RuneCountInString(word) > width:
+				lines = append(lines, sb.String())
+				sb.Reset()
+				sb.WriteString(indent)
+
+			default:
+				sb.WriteString(" ")
+			}
+
+			sb.WriteString(word)
+		}
+
+		lines = append(lines, sb.String())
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// inlineText converts html content to a single line of text
+func inlineText(content string) string {
+	var c textConverter
+
+	c.html(content)
+
+	texts := make([]string, 0, len(c.blocks))
+
+	for _, block := range c.blocks {
+		texts = append(texts, block.text)
+	}
+
+	return strings.Join(strings.Fields(strings.Join(texts, " ")), " ")
+}
+
+// linkText formats a link as its label followed by its URL
+func linkText(label string, href string) string {
+	switch {
+	case href == "" || href == "#":
+		return label
+
+	case label == "":
+		return href
+	}
+
+	return label + ": " + href
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var sb strings.Builder
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(nodeText(child))
+	}
+
+	return sb.String()
+}
+
+// declaredAttr returns the value of an attribute of a component with entities decoded, including attributes set using
+// mj-class and mj-attributes
+func declaredAttr(declared *ast.Attributes, n *ast.Node, name string) string {
+	value, _ := declared.Get(n, name)
+	return html.UnescapeString(value)
+}
+
+func htmlAttr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,168 @@
+package mjml
+
+import (
+	"context"
+	"testing"
+)
+
+const textTemplate = `<mjml>
+  <mj-head>
+    <mj-title>Welcome</mj-title>
+    <mj-preview>Preview text</mj-preview>
+  </mj-head>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-image src="https://example.com/logo.png" alt="Example &amp; Co" />
+        <mj-text>
+          <h1>Welcome</h1>
+          <p>Thanks for signing up. Read the <a href="https://example.com/guide">guide</a> to get the most out of your
+          account.<br>See you soon!</p>
+          <ul><li>Create a project</li><li>Invite your team to collaborate on the project</li></ul>
+        </mj-text>
+        <mj-button href="https://example.com/start">Get started</mj-button>
+      </mj-column>
+    </mj-section>
+    <mj-section>
+      <mj-column>
+        <mj-table>
+          <tr><th>Item</th><th>Price</th></tr>
+          <tr><td>Coffee</td><td>$3.00</td></tr>
+          <tr><td>Sandwich</td><td>$12.50</td></tr>
+        </mj-table>
+        <mj-social>
+          <mj-social-element name="facebook" href="https://facebook.com/example">Facebook</mj-social-element>
+          <mj-social-element name="twitter" href="https://twitter.com/example" />
+        </mj-social>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+func TestText(t *testing.T) {
+
+	tests := []struct {
+		width    int
+		expected string
+	}{
+		{
+			width: DefaultTextWidth,
+			expected: `Example & Co
+
+Welcome
+
+Thanks for signing up. Read the guide (https://example.com/guide) to get the
+most out of your account.
+See you soon!
+
+- Create a project
+- Invite your team to collaborate on the project
+
+Get started: https://example.com/start
+
+Item      Price
+Coffee    $3.00
+Sandwich  $12.50
+
+Facebook: https://facebook.com/example
+twitter: https://twitter.com/example
+`,
+		},
+		{
+			width: 30,
+			expected: `Example & Co
+
+Welcome
+
+Thanks for signing up. Read
+the guide
+(https://example.com/guide) to
+get the most out of your
+account.
+See you soon!
+
+- Create a project
+- Invite your team to
+  collaborate on the project
+
+Get started:
+https://example.com/start
+
+Item      Price
+Coffee    $3.00
+Sandwich  $12.50
+
+Facebook:
+https://facebook.com/example
+twitter:
+https://twitter.com/example
+`,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := Compile(context.Background(), textTemplate, WithTextWidth(test.width))
+
+		if err != nil {
+			t.Fatalf("Error compiling template: %s", err)
+		}
+
+		text, err := result.Text()
+
+		if err != nil {
+			t.Fatalf("Error converting template to text: %s", err)
+		}
+
+		if text != test.expected {
+			t.Errorf("Text with width %d does not match expected text:\n%s", test.width, text)
+		}
+	}
+}
+
+func TestTextDeclaredAttributes(t *testing.T) {
+
+	input := `<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-class name="cta" href="https://example.com/buy" />
+      <mj-navbar base-url="https://example.com" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-button mj-class="cta">Buy now</mj-button>
+        <mj-navbar>
+          <mj-navbar-link href="/about">About</mj-navbar-link>
+        </mj-navbar>
+        <mj-navbar base-url="https://shop.example.com">
+          <mj-navbar-link href="/cart">Cart</mj-navbar-link>
+        </mj-navbar>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	result, err := Compile(context.Background(), input)
+
+	if err != nil {
+		t.Fatalf("Error compiling template: %s", err)
+	}
+
+	text, err := result.Text()
+
+	if err != nil {
+		t.Fatalf("Error converting template to text: %s", err)
+	}
+
+	expected := `Buy now: https://example.com/buy
+
+About: https://example.com/about
+
+Cart: https://shop.example.com/cart
+`
+
+	if text != expected {
+		t.Errorf("Text does not match expected text:\n%s", text)
+	}
+}
@@ -0,0 +1,169 @@
+package mjml
+
+import (
+	"maps"
+	"sort"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// Theme describes defaults that are injected into the mj-head of a template before it is compiled.
+// The theme is merged with the template: fonts, attributes and classes declared by the template take precedence
+// over the ones declared by the theme.
+type Theme struct {
+	// FontFamily is the default font-family of all components
+	FontFamily string
+
+	// TextColor is the default color of mj-text components
+	TextColor string
+
+	// BackgroundColor is the background-color of mj-body
+	BackgroundColor string
+
+	// Fonts are declared using mj-font, so that they are imported when used
+	Fonts Fonts
+
+	// All contains attributes applied to all components using mj-all
+	All map[string]string
+
+	// Components contains the default attributes of components, keyed by tag name (e.g. mj-button)
+	Components map[string]map[string]string
+
+	// Classes contains mj-class definitions, keyed by class name
+	Classes map[string]map[string]string
+}
+
+// WithTheme injects the theme into the mj-head of the template. If more than one theme is provided, later themes take
+// precedence over earlier ones.
+func WithTheme(theme Theme) ToHTMLOption {
+	theme = theme.clone()
+
+	return func(o options) {
+		o.local.themes = append(o.local.themes, theme)
+	}
+}
+
+// clone copies the maps of the theme, so that options created from it do not change when the caller changes them
+func (t Theme) clone() Theme {
+	t.Fonts = maps.Clone(t.Fonts)
+	t.All = maps.Clone(t.All)
+	t.Components = cloneAttributeMaps(t.Components)
+	t.Classes = cloneAttributeMaps(t.Classes)
+
+	return t
+}
+
+func cloneAttributeMaps(m map[string]map[string]string) map[string]map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	cloned := make(map[string]map[string]string, len(m))
+
+	for key, attributes := range m {
+		cloned[key] = maps.Clone(attributes)
+	}
+
+	return cloned
+}
+
+func applyThemes(root *ast.Node, themes []Theme) {
+	if len(themes) == 0 {
+		return
+	}
+
+	var nodes []*ast.Node
+
+	for _, theme := range themes {
+		nodes = append(nodes, theme.nodes()...)
+	}
+
+	// MJML applies the last declaration of a font, attribute or class, so declaring the theme before anything else in
+	// the head lets the template override it
+	head(root).PrependChild(nodes...)
+}
+
+func (t Theme) nodes() []*ast.Node {
+	var nodes []*ast.Node
+
+	for _, name := range sortedKeys(t.Fonts) {
+		nodes = append(nodes, ast.NewElement("mj-font",
+			ast.Attribute{Name: "name", Value: name},
+			ast.Attribute{Name: "href", Value: t.Fonts[name]},
+		))
+	}
+
+	attributes := ast.NewElement("mj-attributes")
+
+	all := map[string]string{}
+
+	if t.FontFamily != "" {
+		all["font-family"] = t.FontFamily
+	}
+
+	for name, value := range t.All {
+		all[name] = value
+	}
+
+	if len(all) > 0 {
+		attributes.AppendChild(attributesElement("mj-all", all))
+	}
+
+	components := map[string]map[string]string{}
+
+	if t.TextColor != "" {
+		components["mj-text"] = map[string]string{"color": t.TextColor}
+	}
+
+	if t.BackgroundColor != "" {
+		components["mj-body"] = map[string]string{"background-color": t.BackgroundColor}
+	}
+
+	for tag, attrs := range t.Components {
+		if components[tag] == nil {
+			components[tag] = map[string]string{}
+		}
+
+		for name, value := range attrs {
+			components[tag][name] = value
+		}
+	}
+
+	for _, tag := range sortedKeys(components) {
+		attributes.AppendChild(attributesElement(tag, components[tag]))
+	}
+
+	for _, name := range sortedKeys(t.Classes) {
+		class := attributesElement("mj-class", t.Classes[name])
+		class.Attributes = append([]ast.Attribute{{Name: "name", Value: name}}, class.Attributes...)
+		attributes.AppendChild(class)
+	}
+
+	if len(attributes.Children) > 0 {
+		nodes = append(nodes, attributes)
+	}
+
+	return nodes
+}
+
+func attributesElement(tag string, attrs map[string]string) *ast.Node {
+	node := ast.NewElement(tag)
+
+	for _, name := range sortedKeys(attrs) {
+		node.SetAttr(name, attrs[name])
+	}
+
+	return node
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
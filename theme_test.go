@@ -0,0 +1,129 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestThemeInjection(t *testing.T) {
+
+	o := newOptions()
+
+	WithTheme(Theme{
+		FontFamily:      "Raleway, Arial",
+		TextColor:       "#333333",
+		BackgroundColor: "#eeeeee",
+		Fonts:           Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"},
+		All:             map[string]string{"padding": "0px"},
+		Components:      map[string]map[string]string{"mj-button": {"background-color": "#ff0000", "color": "#ffffff"}},
+		Classes:         map[string]map[string]string{"muted": {"color": "#999999"}},
+	})(o)
+
+	input := `<mjml>
+  <mj-head>
+    <mj-title>Hello</mj-title>
+  </mj-head>
+  <mj-body></mj-body>
+</mjml>`
+
+	result, _, _, err := o.local.transform(context.Background(), input)
+
+	if err != nil {
+		t.Fatalf("Error applying theme: %s", err)
+	}
+
+	expected := `<mjml>
+  <mj-head>
+    <mj-font name="Raleway" href="https://fonts.googleapis.com/css?family=Raleway" />
+    <mj-attributes>
+      <mj-all font-family="Raleway, Arial" padding="0px" />
+      <mj-body background-color="#eeeeee" />
+      <mj-button background-color="#ff0000" color="#ffffff" />
+      <mj-text color="#333333" />
+      <mj-class name="muted" color="#999999" />
+    </mj-attributes>
+    <mj-title>Hello</mj-title>
+  </mj-head>
+  <mj-body />
+</mjml>
+`
+
+	if result != expected {
+		t.Errorf("Themed mjml does not match expected mjml:\n%s", result)
+	}
+}
+
+func TestThemeMergesWithTemplate(t *testing.T) {
+
+	input := `<mjml>
+  <mj-head>
+    <mj-attributes>
+      <mj-text color="#00ff00" />
+    </mj-attributes>
+  </mj-head>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text>Hello</mj-text>
+        <mj-button>Click</mj-button>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	output, err := ToHTML(context.Background(), input,
+		WithTheme(Theme{TextColor: "#111111"}),
+		WithTheme(Theme{TextColor: "#222222", Components: map[string]map[string]string{"mj-button": {"background-color": "#abcdef"}}}),
+	)
+
+	if err != nil {
+		t.Fatalf("Error converting themed mjml to html: %s", err)
+	}
+
+	if !strings.Contains(output, "color:#00ff00") || strings.Contains(output, "#111111") || strings.Contains(output, "#222222") {
+		t.Error("Expected the text color declared by the template to take precedence over the themes")
+	}
+
+	if !strings.Contains(output, "#abcdef") {
+		t.Error("Expected the button background color from the theme to be applied")
+	}
+}
+
+func TestThemeErrorLines(t *testing.T) {
+
+	input := `<mjml>
+  <mj-body>
+    <mj-section>
+      <mj-column>
+        <mj-text color="not-a-color">Hello</mj-text>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+	theme := WithTheme(Theme{FontFamily: "Arial", TextColor: "#333333", Fonts: Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway"}})
+
+	_, err := ToHTML(context.Background(), input, WithValidationLevel(Soft), theme)
+
+	var mjmlError Error
+
+	if !errors.As(err, &mjmlError) {
+		t.Fatalf("Expected an mjml error, got: %v", err)
+	}
+
+	if len(mjmlError.Details) != 1 || mjmlError.Details[0].Line != 5 {
+		t.Errorf("Expected a single error on line 5 of the template: %s", mjmlError)
+	}
+
+	_, err = ToHTML(context.Background(), input, WithValidationLevel(Strict), theme)
+
+	if !errors.As(err, &mjmlError) {
+		t.Fatalf("Expected an mjml error, got: %v", err)
+	}
+
+	if !strings.Contains(mjmlError.Message, "Line 5 of") {
+		t.Errorf("Expected the strict validation error to be reported on line 5 of the template: %s", mjmlError)
+	}
+}
@@ -0,0 +1,136 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"strings"
+
+	"github.com/Boostport/mjml-go/ast"
+)
+
+// localOptions holds the options that are applied in Go rather than passed to the wasm module
+type localOptions struct {
+	includeFS      fs.FS
+	filePath       string
+	ignoreIncludes bool
+	components     map[string]Component
+	themes         []Theme
+	head           headOptions
+	skeleton       string
+	preprocessors  []func(ctx context.Context, mjml string) (string, error)
+	postprocessors []func(ctx context.Context, html string) (string, error)
+	textWidth      int
+	imageLoader    ImageLoader
+	linkRewriter   func(LinkInfo) string
+	footerHTML     string
+	trackingPixel  string
+	sizeBudget     sizeBudget
+	darkPalette    DarkPalette
+}
+
+// equal reports whether l and other are equal. Options holding functions are never equal, as they cannot be compared.
+func (l *localOptions) equal(other *localOptions) bool {
+	if l.setsFunctions() || other.setsFunctions() {
+		return false
+	}
+
+	return reflect.DeepEqual(l.includeFS, other.includeFS) && l.filePath == other.filePath &&
+		l.ignoreIncludes == other.ignoreIncludes && reflect.DeepEqual(l.themes, other.themes) &&
+		reflect.DeepEqual(l.head, other.head) && l.skeleton == other.skeleton && l.textWidth == other.textWidth &&
+		l.footerHTML == other.footerHTML && l.trackingPixel == other.trackingPixel && l.sizeBudget == other.sizeBudget &&
+		reflect.DeepEqual(l.darkPalette, other.darkPalette)
+}
+
+// setsFunctions reports whether any options holding functions are set
+func (l *localOptions) setsFunctions() bool {
+	return len(l.components) > 0 || len(l.preprocessors) > 0 || len(l.postprocessors) > 0 || l.imageLoader != nil ||
+		l.linkRewriter != nil
+}
+
+// transformsDocument reports whether the mjml document needs to be parsed to apply the options
+func (l *localOptions) transformsDocument() bool {
+	return l.resolvesIncludes() || len(l.components) > 0 || len(l.themes) > 0 || !l.head.empty() ||
+		l.imageLoader != nil || l.linkRewriter != nil || l.darkPalette != nil
+}
+
+// resolvesIncludes reports whether mj-include tags are resolved in Go
+func (l *localOptions) resolvesIncludes() bool {
+	return l.includeFS != nil && !l.ignoreIncludes
+}
+
+// appliedInGo reports whether any options are applied in Go, so they cannot be passed to mjml
+func (l *localOptions) appliedInGo() bool {
+	return l.transformsDocument() || l.filePath != "" || l.skeleton != "" || len(l.preprocessors) > 0 ||
+		len(l.postprocessors) > 0 || l.textWidth != DefaultTextWidth || l.footerHTML != "" || l.trackingPixel != "" ||
+		l.sizeBudget.enabled()
+}
+
+// transform applies the options to the mjml document. It returns the resulting mjml, a map to translate lines
+// in the result back to the original mjml, which is nil if the document did not need to be changed, and the images
+// embedded by WithInlineImages.
+func (l *localOptions) transform(ctx context.Context, mjml string) (string, ast.LineMap, []InlineImage, error) {
+	if !l.transformsDocument() {
+		return mjml, nil, nil, nil
+	}
+
+	root, err := ast.Parse(mjml)
+
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("error parsing mjml: %w", err)
+	}
+
+	if root.Tag != "mjml" {
+		return "", nil, nil, errors.New("root element must be <mjml>")
+	}
+
+	if l.resolvesIncludes() {
+		if err := expandIncludes(root, l.includeFS, l.filePath); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	if err := expandComponents(root, l.components); err != nil {
+		return "", nil, nil, err
+	}
+
+	applyThemes(root, l.themes)
+	applyHead(root, l.head)
+	applyDarkMode(root, l.darkPalette)
+
+	if l.linkRewriter != nil {
+		rewriteLinks(root, l.linkRewriter)
+	}
+
+	var images []InlineImage
+
+	if l.imageLoader != nil {
+		if images, err = inlineImages(ctx, root, l.imageLoader); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	var sb strings.Builder
+
+	lineMap, err := root.RenderWithLineMap(&sb)
+
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("error rendering mjml: %w", err)
+	}
+
+	return sb.String(), lineMap, images, nil
+}
+
+// head returns the mj-head of the document, creating it if it does not exist
+func head(root *ast.Node) *ast.Node {
+	h := root.Child("mj-head")
+
+	if h == nil {
+		h = ast.NewElement("mj-head")
+		root.PrependChild(h)
+	}
+
+	return h
+}
@@ -0,0 +1,450 @@
+package mjml
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strings"
+)
+
+// OptionError is returned by ToHTML when the value of an option is invalid. Options are checked before the template
+// is compiled, so that invalid values are not silently ignored or reported by the JavaScript libraries.
+type OptionError struct {
+	// Option is the name of the option as documented by MJML, for example minifyOptions.ignoreCustomFragments
+	Option string
+
+	// Err describes why the value is invalid
+	Err error
+}
+
+func (e OptionError) Error() string {
+	return fmt.Sprintf("invalid value for option %s: %s", e.Option, e.Err)
+}
+
+func (e OptionError) Unwrap() error {
+	return e.Err
+}
+
+// optionChecks validates the values of options, keyed by the name of the option and the names of the options of
+// html-minifier, js-beautify and juice separated by a dot
+var optionChecks = map[string]func(value interface{}) error{
+	"beautify":        checkBool,
+	"keepComments":    checkBool,
+	"minify":          checkBool,
+	"fonts":           checkFonts,
+	"validationLevel": checkEnum(Strict, Soft, Skip),
+
+	"beautifyOptions.brace_style": checkEnum(
+		BeautifyBraceStyleCollapsePreserveInline,
+		BeautifyBraceStyleCollapse,
+		BeautifyBraceStyleExpand,
+		BeautifyBraceStyleEndExpand,
+		BeautifyBraceStyleNone,
+	),
+	"beautifyOptions.indent_scripts": checkEnum(
+		BeautifyIndentScriptsKeep,
+		BeautifyIndentScriptsSeparate,
+		BeautifyIndentScriptsNormal,
+	),
+	"beautifyOptions.wrap_attributes": checkEnum(
+		BeautifyWrapAttributesAuto,
+		BeautifyWrapAttributesForce,
+		BeautifyWrapAttributesForceAligned,
+		BeautifyWrapAttributesForceExpandMultiline,
+		BeautifyWrapAttributesAlignedMultiple,
+		BeautifyWrapAttributesPreserve,
+		BeautifyWrapAttributesPreserveAligned,
+	),
+	"beautifyOptions.templating": checkEnumList(
+		BeautifyTemplatingAuto,
+		BeautifyTemplatingNone,
+		BeautifyTemplatingDjango,
+		BeautifyTemplatingERB,
+		BeautifyTemplatingHandlebars,
+		BeautifyTemplatingPHP,
+		BeautifyTemplatingSmarty,
+	),
+
+	"ignoreIncludes":       checkBool,
+	"actualPath":           unsupportedOption("includes are resolved relative to the path set using WithFilePath"),
+	"filePath":             unsupportedOption("use WithFilePath"),
+	"mjmlConfigPath":       unsupportedOption("load the config using LoadOptions"),
+	"preprocessors":        checkPreprocessors,
+	"skeleton":             unsupportedOption("use WithSkeleton"),
+	"useMjmlConfigOptions": unsupportedOption("load the config using LoadOptions"),
+
+	"minifyOptions.customAttrAssign":      checkRegexpList,
+	"minifyOptions.customAttrCollapse":    checkRegexp,
+	"minifyOptions.customAttrSurround":    checkRegexpList,
+	"minifyOptions.ignoreCustomComments":  checkRegexpList,
+	"minifyOptions.ignoreCustomFragments": checkRegexpList,
+	"minifyOptions.quoteCharacter":        checkEnum(HTMLMinifierSingleQuote, HTMLMinifierDoubleQuote),
+}
+
+// validate checks the values of the options passed to mjml, returning an OptionError for the first invalid value
+func (o options) validate() error {
+	if err := o.local.checkSkeleton(); err != nil {
+		return err
+	}
+
+	if err := o.local.checkTrackingPixel(); err != nil {
+		return err
+	}
+
+	if err := o.local.checkSizeBudget(); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(o.data) {
+		if err := checkOption(name, o.data[name]); err != nil {
+			return err
+		}
+
+		nested, ok := o.data[name].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, key := range sortedKeys(nested) {
+			if err := checkOption(name+"."+key, nested[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkOption(name string, value interface{}) error {
+	check, ok := optionChecks[name]
+
+	if !ok {
+		return nil
+	}
+
+	if err := check(value); err != nil {
+		return OptionError{Option: name, Err: err}
+	}
+
+	return nil
+}
+
+// unsupportedOption rejects options of mjml2html that cannot be used by the WebAssembly module, as they require
+// access to the file system or JavaScript functions
+func unsupportedOption(alternative string) func(interface{}) error {
+	return func(interface{}) error {
+		return fmt.Errorf("not supported by the WebAssembly module, %s", alternative)
+	}
+}
+
+// checkPreprocessors rejects preprocessors passed as strings, as mjml expects JavaScript functions. Empty lists are
+// ignored by mjml and accepted.
+func checkPreprocessors(value interface{}) error {
+	var preprocessors []string
+
+	if err := convertValue(value, &preprocessors); err != nil {
+		return err
+	}
+
+	if len(preprocessors) > 0 {
+		return unsupportedOption("use WithPreprocessor")(value)
+	}
+
+	return nil
+}
+
+func checkBool(value interface{}) error {
+	var b bool
+	return convertValue(value, &b)
+}
+
+func checkFonts(value interface{}) error {
+	var fonts Fonts
+
+	if err := convertValue(value, &fonts); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(fonts) {
+		if name == "" {
+			return errors.New("font name is empty")
+		}
+
+		u, err := url.Parse(fonts[name])
+
+		if err != nil {
+			return fmt.Errorf("font %s: %w", name, err)
+		}
+
+		// Protocol-relative URLs are allowed, as they are commonly used for fonts
+		if (u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("font %s: %q is not an http or https URL", name, fonts[name])
+		}
+	}
+
+	return nil
+}
+
+func checkEnum[T ~string](values ...T) func(interface{}) error {
+	return func(value interface{}) error {
+		var s T
+
+		if err := convertValue(value, &s); err != nil {
+			return err
+		}
+
+		if !slices.Contains(values, s) {
+			return fmt.Errorf("%q is not one of %q", s, values)
+		}
+
+		return nil
+	}
+}
+
+func checkEnumList[T ~string](values ...T) func(interface{}) error {
+	check := checkEnum(values...)
+
+	return func(value interface{}) error {
+		var list []T
+
+		if err := convertValue(value, &list); err != nil {
+			return err
+		}
+
+		for _, v := range list {
+			if err := check(v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func checkRegexp(value interface{}) error {
+	var pattern string
+
+	if err := convertValue(value, &pattern); err != nil {
+		return err
+	}
+
+	return checkJSRegexp(pattern)
+}
+
+// checkRegexpList checks a list of regular expressions, which may also be a single regular expression, like
+// parseStringRegExpArray in lib.js
+func checkRegexpList(value interface{}) error {
+	var patterns []string
+
+	if err := convertValue(value, &patterns); err != nil {
+		return checkRegexp(value)
+	}
+
+	for _, pattern := range patterns {
+		if err := checkJSRegexp(pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsSlashes matches the slashes around a regular expression, which lib.js removes before calling new RegExp
+var jsSlashes = regexp.MustCompile(`^/(.*)/$`)
+
+// checkJSRegexp checks that pattern is a valid JavaScript regular expression. Constructs that Go supports but
+// JavaScript does not, such as inline flags, are rejected, and constructs that only JavaScript supports, such as
+// lookarounds and backreferences, are replaced so that the rest of the pattern can be checked by regexp.
+func checkJSRegexp(pattern string) error {
+	pattern = jsSlashes.ReplaceAllString(pattern, "$1")
+
+	var (
+		sb      strings.Builder
+		inClass bool
+	)
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch {
+		case c == '\\':
+			replacement, n, err := jsEscape(pattern[i:], inClass)
+
+			if err != nil {
+				return fmt.Errorf("%q: %w", pattern, err)
+			}
+
+			sb.WriteString(replacement)
+			i += n - 1
+
+		case c == '[' && !inClass:
+			switch {
+			case strings.HasPrefix(pattern[i:], "[]"):
+				// [] matches nothing in JavaScript, while regexp treats the ] as a literal
+				sb.WriteString(`[^\x00-\x{10FFFF}]`)
+				i++
+
+			case strings.HasPrefix(pattern[i:], "[^]"):
+				// [^] matches anything in JavaScript
+				sb.WriteString(`[\x00-\x{10FFFF}]`)
+				i += 2
+
+			default:
+				inClass = true
+				sb.WriteByte(c)
+			}
+
+		case c == ']' && inClass:
+			inClass = false
+			sb.WriteByte(c)
+
+		case c == '{' && !inClass && jsRepeat.MatchString(pattern[i:]):
+			repeat := jsRepeat.FindStringSubmatch(pattern[i:])
+			sb.WriteString(clampRepeat(repeat))
+			i += len(repeat[0]) - 1
+
+		case c == '(' && !inClass && strings.HasPrefix(pattern[i:], "(?"):
+			group := pattern[i+2:]
+
+			switch {
+			case strings.HasPrefix(group, ":"), strings.HasPrefix(group, "="), strings.HasPrefix(group, "!"):
+				sb.WriteString("(?:")
+				i += 2
+
+			case strings.HasPrefix(group, "<="), strings.HasPrefix(group, "<!"):
+				sb.WriteString("(?:")
+				i += 3
+
+			case strings.HasPrefix(group, "<"):
+				sb.WriteString("(?P<")
+				i += 2
+
+			default:
+				return fmt.Errorf("%q: group (?%s is not supported by JavaScript", pattern, firstRune(group))
+			}
+
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	if _, err := regexp.Compile(sb.String()); err != nil {
+		var syntaxError *syntax.Error
+
+		if errors.As(err, &syntaxError) {
+			return fmt.Errorf("%q: %s", pattern, syntaxError.Code)
+		}
+
+		return fmt.Errorf("%q: %w", pattern, err)
+	}
+
+	return nil
+}
+
+// jsRepeat matches a repeat count, such as {2,5}
+var jsRepeat = regexp.MustCompile(`^\{(\d+)(?:(,)(\d*))?\}`)
+
+// maxRepeat is the largest repeat count accepted by regexp, while JavaScript has no limit
+const maxRepeat = "1000"
+
+// clampRepeat converts a repeat count matched by jsRepeat to one accepted by regexp, limiting counts to maxRepeat.
+// Counts out of order are kept as they are, so that they are still reported.
+func clampRepeat(repeat []string) string {
+	minimum, comma, maximum := repeat[1], repeat[2], repeat[3]
+
+	if maximum != "" && compareCounts(minimum, maximum) > 0 {
+		return repeat[0]
+	}
+
+	if compareCounts(minimum, maxRepeat) > 0 {
+		minimum = maxRepeat
+	}
+
+	if compareCounts(maximum, maxRepeat) > 0 {
+		maximum = maxRepeat
+	}
+
+	return "{" + minimum + comma + maximum + "}"
+}
+
+// compareCounts compares two repeat counts of any length
+func compareCounts(a string, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+
+	if len(a) != len(b) {
+		return cmp.Compare(len(a), len(b))
+	}
+
+	return strings.Compare(a, b)
+}
+
+// jsHex matches the digits of \u escapes
+var jsHex = regexp.MustCompile(`^[0-9a-fA-F]{4}`)
+
+// jsEscape converts the escape at the start of s to an equivalent accepted by regexp, returning the number of bytes
+// of s it consumed
+func jsEscape(s string, inClass bool) (string, int, error) {
+	if len(s) < 2 {
+		return "", 0, errors.New("\\ at end of pattern")
+	}
+
+	next := s[1]
+
+	switch {
+	case next >= '1' && next <= '9' && !inClass:
+		// backreference
+		n := 2
+
+		for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+			n++
+		}
+
+		return "(?:)", n, nil
+
+	case next >= '0' && next <= '9':
+		// octal escape in a class
+		return "0", 2, nil
+
+	case next == 'k' && strings.HasPrefix(s[2:], "<") && !inClass:
+		end := strings.IndexByte(s, '>')
+
+		if end < 0 {
+			return "", 0, errors.New("invalid named backreference")
+		}
+
+		return "(?:)", end + 1, nil
+
+	case next == 'u' && jsHex.MatchString(s[2:]):
+		return `\x{` + s[2:6] + `}`, 6, nil
+
+	case next == 'c' && len(s) > 2 && isASCIILetter(s[2]):
+		return "x", 3, nil
+
+	case strings.IndexByte("bBdDsSwWfnrtvx", next) >= 0:
+		return s[:2], 2, nil
+
+	case isASCIILetter(next):
+		// identity escape, such as \q, which matches the letter itself
+		return string(next), 2, nil
+	}
+
+	return s[:2], 2, nil
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func firstRune(s string) string {
+	for _, r := range s {
+		return string(r)
+	}
+
+	return ""
+}
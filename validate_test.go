@@ -0,0 +1,90 @@
+package mjml
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestJSRegexp(t *testing.T) {
+
+	valid := []string{
+		`<#.*#>`,
+		`/\{\{.*?\}\}/`,
+		`(?<=a)b(?!c)`,
+		`(?<name>a)\k<name>`,
+		`(a)\1`,
+		`é\cJ\q`,
+		`[^][]`,
+		`[\d\-\1]`,
+		`\d{1,2000}`,
+		`a{5000}b{0010,}`,
+		`a{99999999999999999999}`,
+	}
+
+	for _, pattern := range valid {
+		if err := checkJSRegexp(pattern); err != nil {
+			t.Errorf("Expected %s to be valid, got: %s", pattern, err)
+		}
+	}
+
+	invalid := []string{
+		`<#(.*`,
+		`(?i)abc`,
+		`(?P<name>a)`,
+		`a**`,
+		`[z-a]`,
+		`abc\`,
+		`a{2000,1500}`,
+	}
+
+	for _, pattern := range invalid {
+		if err := checkJSRegexp(pattern); err == nil {
+			t.Errorf("Expected %s to be invalid", pattern)
+		}
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+
+	tests := map[string]ToHTMLOption{
+		"validationLevel":                    WithValidationLevel("lenient"),
+		"fonts":                              WithFonts(Fonts{"Raleway": "fonts/raleway.css"}),
+		"beautifyOptions.wrap_attributes":    WithBeautifyOptions(NewBeautifyOptions().WrapAttributes("sometimes")),
+		"beautifyOptions.templating":         WithBeautifyOptions(NewBeautifyOptions().Templating([]BeautifyTemplating{"jinja"})),
+		"minifyOptions.customAttrCollapse":   WithMinifyOptions(NewHTMLMinifierOptions().CustomAttrCollapse("(")),
+		"minifyOptions.ignoreCustomComments": WithMinifyOptions(NewHTMLMinifierOptions().IgnoreCustomComments([]string{"^!", "(?s).*"})),
+		"minifyOptions.quoteCharacter":       WithMinifyOptions(NewHTMLMinifierOptions().QuoteCharacter("`")),
+		"minify":                             WithRawOptions(map[string]interface{}{"minify": "yes"}),
+		"actualPath":                         WithRawOptions(map[string]interface{}{"actualPath": "."}),
+		"mjmlConfigPath":                     WithRawOptions(map[string]interface{}{"mjmlConfigPath": ".mjmlconfig"}),
+		"skeleton":                           WithSkeleton("{{.Content"),
+		"trackingPixel":                      WithTrackingPixel("/open.gif"),
+		"sizeBudget":                         WithSizeBudget(0, Strict),
+	}
+
+	for option, toHTMLOption := range tests {
+		_, err := ToHTML(context.Background(), "<mjml><mj-body></mj-body></mjml>", toHTMLOption)
+
+		var optionError OptionError
+
+		if !errors.As(err, &optionError) || optionError.Option != option {
+			t.Errorf("Expected an option error for %s, got: %v", option, err)
+		}
+	}
+
+	o := newOptions()
+
+	for _, toHTMLOption := range []ToHTMLOption{
+		WithFonts(Fonts{"Raleway": "https://fonts.googleapis.com/css?family=Raleway", "Lato": "//fonts.googleapis.com/css?family=Lato"}),
+		WithBeautifyOptions(NewBeautifyOptions().WrapAttributes(BeautifyWrapAttributesForce).Templating([]BeautifyTemplating{BeautifyTemplatingDjango})),
+		WithMinifyOptions(NewHTMLMinifierOptions().IgnoreCustomFragments([]string{"<#.*#>"}).QuoteCharacter(HTMLMinifierSingleQuote)),
+		WithRawOptions(map[string]interface{}{"validationLevel": "strict"}),
+	} {
+		toHTMLOption(o)
+	}
+
+	if err := o.validate(); err != nil {
+		t.Errorf("Expected valid options, got: %s", err)
+	}
+}